@@ -20,6 +20,9 @@ pub struct Child {
     #[getset(get = "pub")]
     timeout: Option<Instant>,
 
+    #[getset(get_copy = "pub")]
+    exit_file_write_retries: u32,
+
     #[getset(get = "pub")]
     io: SharedContainerIO,
 }
@@ -31,6 +34,7 @@ impl Child {
         exit_paths: Vec<PathBuf>,
         oom_exit_paths: Vec<PathBuf>,
         timeout: Option<Instant>,
+        exit_file_write_retries: u32,
         io: SharedContainerIO,
     ) -> Self {
         Self {
@@ -39,6 +43,7 @@ impl Child {
             exit_paths,
             oom_exit_paths,
             timeout,
+            exit_file_write_retries,
             io,
         }
     }
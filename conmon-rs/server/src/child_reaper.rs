@@ -23,6 +23,7 @@ use std::{
     process::Stdio,
     str,
     sync::{Arc, Mutex},
+    time::Duration,
 };
 use tokio::{
     fs::{self, File},
@@ -62,6 +63,7 @@ impl ChildReaper {
         args: I,
         container_io: &mut ContainerIO,
         pidfile: &Path,
+        stdin: bool,
     ) -> Result<u32>
     where
         P: AsRef<OsStr>,
@@ -71,7 +73,7 @@ impl ChildReaper {
         let mut cmd = Command::new(cmd);
         cmd.args(args);
         let mut child = cmd
-            .stdin(Stdio::piped())
+            .stdin(if stdin { Stdio::piped() } else { Stdio::null() })
             .stdout(Stdio::piped())
             .stderr(Stdio::piped())
             .spawn()
@@ -206,7 +208,7 @@ pub struct ReapableChild {
     #[getset(get)]
     oom_exit_paths: Vec<PathBuf>,
 
-    #[getset(get_copy)]
+    #[getset(get_copy = "pub")]
     pid: u32,
 
     #[getset(get = "pub")]
@@ -215,6 +217,9 @@ pub struct ReapableChild {
     #[getset(get = "pub")]
     timeout: Option<Instant>,
 
+    #[getset(get_copy)]
+    exit_file_write_retries: u32,
+
     #[getset(get = "pub")]
     token: CancellationToken,
 
@@ -234,6 +239,10 @@ pub struct ExitChannelData {
 }
 
 impl ReapableChild {
+    /// The initial duration to wait before retrying a failed exit file write, which gets doubled
+    /// on every retry.
+    const EXIT_FILE_WRITE_BACKOFF: Duration = Duration::from_millis(100);
+
     pub fn from_child(child: &Child) -> Self {
         Self {
             exit_paths: child.exit_paths().clone(),
@@ -241,6 +250,7 @@ impl ReapableChild {
             pid: child.pid(),
             io: child.io().clone(),
             timeout: *child.timeout(),
+            exit_file_write_retries: child.exit_file_write_retries(),
             token: CancellationToken::new(),
             task: None,
         }
@@ -270,6 +280,7 @@ impl ReapableChild {
         let (exit_tx, exit_rx) = broadcast::channel(1);
         let exit_tx_clone = exit_tx.clone();
         let timeout = *self.timeout();
+        let exit_file_write_retries = self.exit_file_write_retries();
         let stop_token = self.token().clone();
 
         let task = task::spawn(
@@ -319,7 +330,9 @@ impl ReapableChild {
                         .collect::<Vec<_>>()
                         .join(", ")
                 );
-                if let Err(e) = Self::write_to_exit_paths(exit_code, &exit_paths).await {
+                if let Err(e) =
+                    Self::write_to_exit_paths(exit_code, &exit_paths, exit_file_write_retries).await
+                {
                     error!(pid, "Could not write exit paths: {:#}", e);
                 }
                 debug!("Sending exit struct to channel: {:?}", exit_channel_data);
@@ -372,7 +385,7 @@ impl ReapableChild {
         }
     }
 
-    async fn write_to_exit_paths(code: i32, paths: &[PathBuf]) -> Result<()> {
+    async fn write_to_exit_paths(code: i32, paths: &[PathBuf], retries: u32) -> Result<()> {
         let paths = paths.to_owned();
         let tasks: Vec<_> = paths
             .into_iter()
@@ -381,17 +394,23 @@ impl ReapableChild {
                 tokio::spawn(
                     async move {
                         let code_str = format!("{}", code);
-                        debug!("Creating exit file");
-                        if let Ok(mut fp) = File::create(&path_buf).await {
-                            debug!(code, "Writing exit code to file");
-                            if let Err(e) = fp.write_all(code_str.as_bytes()).await {
-                                error!("Could not write exit file to path: {:#}", e);
-                            }
-                            debug!("Flushing file");
-                            if let Err(e) = fp.flush().await {
-                                error!("Unable to flush {}: {:#}", path_buf.display(), e);
+                        let mut backoff = Self::EXIT_FILE_WRITE_BACKOFF;
+                        for attempt in 0..=retries {
+                            match Self::write_exit_file(&path_buf, &code_str).await {
+                                Ok(()) => break,
+                                Err(e) if attempt < retries => {
+                                    warn!(
+                                        "Unable to write exit file (attempt {} of {}), retrying in {:?}: {:#}",
+                                        attempt + 1,
+                                        retries + 1,
+                                        backoff,
+                                        e
+                                    );
+                                    time::sleep(backoff).await;
+                                    backoff *= 2;
+                                }
+                                Err(e) => error!("Could not write exit file to path: {:#}", e),
                             }
-                            debug!("Done writing exit file");
                         }
                     }
                     .instrument(debug_span!("write_exit_path", path)),
@@ -405,4 +424,19 @@ impl ReapableChild {
 
         Ok(())
     }
+
+    async fn write_exit_file(path: &Path, code: &str) -> Result<()> {
+        debug!("Creating exit file");
+        let mut fp = File::create(path)
+            .await
+            .context(format!("create exit file {}", path.display()))?;
+        debug!(code, "Writing exit code to file");
+        fp.write_all(code.as_bytes()).await.context("write exit code")?;
+        debug!("Flushing file");
+        fp.flush()
+            .await
+            .context(format!("flush {}", path.display()))?;
+        debug!("Done writing exit file");
+        Ok(())
+    }
 }
@@ -1,9 +1,9 @@
 use crate::{container_io::Pipe, cri_logger::CriLogger};
-use anyhow::Result;
+use anyhow::{Context, Result};
 use capnp::struct_list::Reader;
 use conmon_common::conmon_capnp::conmon::log_driver::{Owned, Type};
 use futures::future::join_all;
-use std::sync::Arc;
+use std::{path::Path, sync::Arc};
 use tokio::{io::AsyncBufRead, sync::RwLock};
 
 pub type SharedContainerLog = Arc<RwLock<ContainerLog>>;
@@ -78,6 +78,41 @@ impl ContainerLog {
         Ok(())
     }
 
+    /// Switch the log driver writing to `path` over to `new_path`.
+    pub async fn swap_path(&mut self, path: &Path, new_path: &Path) -> Result<()> {
+        let driver = self
+            .drivers
+            .iter_mut()
+            .find(|x| match x {
+                LogDriver::ContainerRuntimeInterface(cri_logger) => cri_logger.path() == path,
+            })
+            .context(format!("no log driver found for path {}", path.display()))?;
+        match driver {
+            LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                cri_logger.swap_path(new_path).await
+            }
+        }
+    }
+
+    /// Read the contents of the first log driver, limited to the last `tail` lines written
+    /// after `since` and `max_bytes` in size.
+    pub async fn read(
+        &mut self,
+        tail: u64,
+        since: i64,
+        max_bytes: usize,
+    ) -> Result<(Vec<u8>, bool)> {
+        match self
+            .drivers
+            .first_mut()
+            .context("no log driver configured")?
+        {
+            LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                cri_logger.read(tail, since, max_bytes).await
+            }
+        }
+    }
+
     /// Write the contents of the provided reader into all loggers.
     pub async fn write<T>(&mut self, pipe: Pipe, bytes: T) -> Result<()>
     where
@@ -1,11 +1,19 @@
 //! File logging functionalities.
 
 use crate::container_io::Pipe;
+<<<<<<< HEAD
 use anyhow::{Context, Result};
+=======
+use anyhow::{bail, format_err, Context, Result};
+use conmon_common::conmon_capnp::conmon::log_driver::TimestampFormat;
+>>>>>>> acf1cda ([containers/conmon-rs#synth-773] fix: filter the container log on the server)
 use getset::{CopyGetters, Getters, Setters};
 use memchr::memchr;
 use std::{
+    collections::VecDeque,
+    convert::TryFrom,
     marker::Unpin,
+    mem,
     path::{Path, PathBuf},
 };
 use tokio::{
@@ -18,7 +26,7 @@ use tz::{DateTime, TimeZone};
 #[derive(Debug, CopyGetters, Getters, Setters)]
 /// The main structure used for container log handling.
 pub struct CriLogger {
-    #[getset(get)]
+    #[getset(get, set)]
     /// Path to the file on disk.
     path: PathBuf,
 
@@ -34,6 +42,9 @@ pub struct CriLogger {
 impl CriLogger {
     const ERR_UNINITIALIZED: &'static str = "logger not initialized";
 
+    /// Tag of a record which gets continued by the following one.
+    const TAG_PARTIAL: &'static [u8] = b"P";
+
     /// Create a new file logger instance.
     pub fn new<T: AsRef<Path>>(path: T, max_log_size: Option<usize>) -> Result<CriLogger> {
         Ok(Self {
@@ -139,6 +150,124 @@ impl CriLogger {
         self.init().await
     }
 
+    /// Switch the container log file to the provided path. All pending data
+    /// is written to the previous file before the new one gets opened.
+    pub async fn swap_path<T: AsRef<Path>>(&mut self, path: T) -> Result<()> {
+        debug!(
+            "Swap container log path from {} to {}",
+            self.path().display(),
+            path.as_ref().display()
+        );
+        let file = self.file.as_mut().context(Self::ERR_UNINITIALIZED)?;
+        file.flush().await.context("flush file writer")?;
+        file.get_ref().sync_all().await?;
+        let new_file = Self::open(&path).await?;
+        self.set_path(path.as_ref().into());
+        self.set_file(new_file.into());
+        Ok(())
+    }
+
+    /// Read the contents of the current container log file, whereas rotated files are not
+    /// considered. Partial records are combined with their following records up to the full one,
+    /// which makes them a single line. Only the last `tail` lines (all if zero) written after
+    /// `since` (nanoseconds since the unix epoch, all if zero) are returned. The oldest lines are
+    /// omitted if the result exceeds `max_bytes`, which is indicated by the returned flag.
+    pub async fn read(
+        &mut self,
+        tail: u64,
+        since: i64,
+        max_bytes: usize,
+    ) -> Result<(Vec<u8>, bool)> {
+        self.flush().await?;
+        let file = File::open(self.path())
+            .await
+            .context(format!("open log file path '{}'", self.path().display()))?;
+        let mut reader = BufReader::new(file);
+        let mut lines = LogLines::new(tail, max_bytes);
+        let mut line = vec![];
+        let mut skip = false;
+
+        loop {
+            let start = line.len();
+            if reader
+                .read_until(b'\n', &mut line)
+                .await
+                .context("read log file")?
+                == 0
+            {
+                break;
+            }
+
+            let record = &line[start..];
+            let mut parts = record.splitn(4, |b| *b == b' ');
+            let full = match (parts.next(), parts.next(), parts.next(), parts.next()) {
+                (Some(timestamp), Some(_), Some(tag), Some(_)) => {
+                    skip = since > 0
+                        && Self::parse_timestamp(std::str::from_utf8(timestamp)?)? <= since;
+                    tag != Self::TAG_PARTIAL
+                }
+                _ => bail!("invalid log record: {}", String::from_utf8_lossy(record)),
+            };
+
+            if full {
+                let full_line = mem::take(&mut line);
+                if !skip {
+                    lines.push(full_line);
+                }
+            }
+        }
+
+        // Partial records at the end of the file belong to a line which is still being written.
+        if !line.is_empty() && !skip {
+            lines.push(line);
+        }
+
+        Ok(lines.into_inner())
+    }
+
+    /// Parse the provided RFC3339 timestamp into nanoseconds since the unix epoch.
+    fn parse_timestamp(timestamp: &str) -> Result<i64> {
+        let err = || format_err!("invalid timestamp: {}", timestamp);
+        let numbers = |s: &str, sep: char| -> Result<Vec<i64>> {
+            s.split(sep).map(|x| x.parse().map_err(|_| err())).collect()
+        };
+
+        let (date, time) = timestamp.split_once('T').ok_or_else(err)?;
+        let (clock, offset) = match time.strip_suffix('Z') {
+            Some(clock) => (clock, 0),
+            None => {
+                let (clock, offset) =
+                    time.split_at(time.rfind(|c| c == '+' || c == '-').ok_or_else(err)?);
+                let hours_minutes = numbers(&offset[1..], ':')?;
+                let [hours, minutes] = <[i64; 2]>::try_from(hours_minutes).map_err(|_| err())?;
+                let sign = if offset.starts_with('-') { -1 } else { 1 };
+                (clock, sign * (hours * 3600 + minutes * 60))
+            }
+        };
+        let (clock, fraction) = clock.split_once('.').unwrap_or((clock, ""));
+
+        let [year, month, day] = <[i64; 3]>::try_from(numbers(date, '-')?).map_err(|_| err())?;
+        let [hour, minute, second] =
+            <[i64; 3]>::try_from(numbers(clock, ':')?).map_err(|_| err())?;
+        let nanos: i64 = if fraction.is_empty() {
+            0
+        } else {
+            format!("{:0<9.9}", fraction).parse().map_err(|_| err())?
+        };
+
+        // Days since the unix epoch of the civil date, see:
+        // https://howardhinnant.github.io/date_algorithms.html#days_from_civil
+        let year = if month <= 2 { year - 1 } else { year };
+        let era = if year >= 0 { year } else { year - 399 } / 400;
+        let year_of_era = year - era * 400;
+        let day_of_year = (153 * ((month + 9) % 12) + 2) / 5 + day - 1;
+        let day_of_era = year_of_era * 365 + year_of_era / 4 - year_of_era / 100 + day_of_year;
+        let days = era * 146_097 + day_of_era - 719_468;
+
+        let seconds = days * 86_400 + hour * 3600 + minute * 60 + second - offset;
+        Ok(seconds * 1_000_000_000 + nanos)
+    }
+
     /// Ensures that all content is written to disk.
     pub async fn flush(&mut self) -> Result<()> {
         self.file
@@ -186,6 +315,52 @@ impl CriLogger {
     }
 }
 
+/// The lines read from a log file, limited by their number and size.
+struct LogLines {
+    lines: VecDeque<Vec<u8>>,
+    tail: u64,
+    max_bytes: usize,
+    size: usize,
+    truncated: bool,
+}
+
+impl LogLines {
+    fn new(tail: u64, max_bytes: usize) -> Self {
+        Self {
+            lines: VecDeque::new(),
+            tail,
+            max_bytes,
+            size: 0,
+            truncated: false,
+        }
+    }
+
+    /// Add a line and drop the oldest ones exceeding the limits.
+    fn push(&mut self, line: Vec<u8>) {
+        self.size += line.len();
+        self.lines.push_back(line);
+
+        if self.tail > 0 && self.lines.len() as u64 > self.tail {
+            self.pop_front();
+        }
+        while self.size > self.max_bytes && !self.lines.is_empty() {
+            self.pop_front();
+            self.truncated = true;
+        }
+    }
+
+    fn pop_front(&mut self) {
+        if let Some(line) = self.lines.pop_front() {
+            self.size -= line.len();
+        }
+    }
+
+    /// Returns the concatenated lines and if some got dropped because of their size.
+    fn into_inner(self) -> (Vec<u8>, bool) {
+        (self.lines.into_iter().flatten().collect(), self.truncated)
+    }
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -260,6 +435,141 @@ mod tests {
         Ok(())
     }
 
+    #[tokio::test]
+    async fn write_swap_path() -> Result<()> {
+        let old_file = NamedTempFile::new()?;
+        let old_path = old_file.path();
+        let new_file = NamedTempFile::new()?;
+        let new_path = new_file.path();
+        let mut sut = CriLogger::new(old_path, None)?;
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, "a\n".as_bytes()).await?;
+        sut.swap_path(new_path).await?;
+        sut.write(Pipe::StdOut, "b\n".as_bytes()).await?;
+        assert_eq!(sut.path(), new_path);
+
+        let res = fs::read_to_string(old_path)?;
+        assert!(res.contains(" stdout F a"));
+        assert!(!res.contains(" stdout F b"));
+
+        let res = fs::read_to_string(new_path)?;
+        assert!(!res.contains(" stdout F a"));
+        assert!(res.contains(" stdout F b"));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn read_success() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let path = file.path();
+        let mut sut = CriLogger::new(path, None)?;
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, "a\nb\n".as_bytes()).await?;
+
+        let (res, truncated) = sut.read(0, 0, usize::MAX).await?;
+        let res = String::from_utf8(res)?;
+        assert!(res.contains(" stdout F a"));
+        assert!(res.contains(" stdout F b"));
+        assert!(!truncated);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn read_tail_partial() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let path = file.path();
+        let mut sut = CriLogger::new(path, None)?;
+        sut.init().await?;
+
+        fs::write(
+            path,
+            "2022-08-01T10:00:00Z stdout F a\n\
+             2022-08-01T10:00:01Z stdout P b\n\
+             2022-08-01T10:00:02Z stdout P c\n\
+             2022-08-01T10:00:03Z stdout F d\n",
+        )?;
+
+        let (res, _) = sut.read(1, 0, usize::MAX).await?;
+        assert_eq!(
+            String::from_utf8(res)?,
+            "2022-08-01T10:00:01Z stdout P b\n\
+             2022-08-01T10:00:02Z stdout P c\n\
+             2022-08-01T10:00:03Z stdout F d\n"
+        );
+
+        let (res, _) = sut.read(2, 0, usize::MAX).await?;
+        assert!(String::from_utf8(res)?.starts_with("2022-08-01T10:00:00Z stdout F a\n"));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn read_since() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let path = file.path();
+        let mut sut = CriLogger::new(path, None)?;
+        sut.init().await?;
+
+        fs::write(
+            path,
+            "2022-08-01T10:00:00Z stdout F a\n\
+             2022-08-01T12:00:01+02:00 stdout F b\n\
+             2022-08-01T10:00:02.5Z stderr F c\n",
+        )?;
+        let since = OffsetDateTime::parse("2022-08-01T10:00:01Z", &Rfc3339)?;
+
+        let (res, _) = sut.read(0, 0, usize::MAX).await?;
+        assert_eq!(String::from_utf8(res)?.lines().count(), 3);
+
+        let (res, _) = sut
+            .read(0, since.unix_timestamp_nanos() as i64, usize::MAX)
+            .await?;
+        assert_eq!(
+            String::from_utf8(res)?,
+            "2022-08-01T10:00:02.5Z stderr F c\n"
+        );
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn read_max_bytes() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let path = file.path();
+        let mut sut = CriLogger::new(path, None)?;
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, "a\nb\nc\n".as_bytes()).await?;
+        let (res, _) = sut.read(0, 0, usize::MAX).await?;
+
+        let (res, truncated) = sut.read(0, 0, res.len() - 1).await?;
+        let res = String::from_utf8(res)?;
+        assert!(truncated);
+        assert!(!res.contains(" stdout F a"));
+        assert!(res.contains(" stdout F b"));
+        assert!(res.contains(" stdout F c"));
+        Ok(())
+    }
+
+    #[test]
+    fn parse_timestamp() -> Result<()> {
+        for timestamp in [
+            "1970-01-01T00:00:00Z",
+            "2022-08-01T12:34:56.123456789+02:00",
+            "2022-08-01T10:34:56.123Z",
+            "1999-12-31T23:59:59-05:30",
+            "2000-02-29T00:00:00.5Z",
+        ] {
+            assert_eq!(
+                CriLogger::parse_timestamp(timestamp)?,
+                OffsetDateTime::parse(timestamp, &Rfc3339)?.unix_timestamp_nanos() as i64,
+            );
+        }
+        assert!(CriLogger::parse_timestamp("2022-08-01").is_err());
+        assert!(CriLogger::parse_timestamp("2022-08-01T10:00:00").is_err());
+        Ok(())
+    }
+
     #[tokio::test]
     async fn init_failure() -> Result<()> {
         let mut sut = CriLogger::new("/file/does/not/exist", None)?;
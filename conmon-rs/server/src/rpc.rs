@@ -9,8 +9,10 @@ use crate::{
 use anyhow::{format_err, Context};
 use capnp::{capability::Promise, Error};
 use capnp_rpc::pry;
-use conmon_common::conmon_capnp::conmon;
+use conmon_common::conmon_capnp::conmon::{self, namespace};
 use std::{
+    fs,
+    io::ErrorKind,
     path::{Path, PathBuf},
     str,
     time::Duration,
@@ -19,6 +21,40 @@ use tokio::time::Instant;
 use tracing::{debug, debug_span, error, Instrument};
 use uuid::Uuid;
 
+<<<<<<< HEAD
+=======
+/// The maximum size of the log data returned by a single read log request, which leaves enough room
+/// for the remaining response within the traversal limit of the client.
+const MAX_READ_LOG_BYTES: usize = MAX_MESSAGE_WORDS * 8 / 2;
+
+/// The names of all methods implemented by the server, which have to be kept in sync with the
+/// protocol.
+const METHODS: &[&str] = &[
+    "version",
+    "createContainer",
+    "execSyncContainer",
+    "attachContainer",
+    "reopenLogContainer",
+    "setWindowSizeContainer",
+    "swapLogPath",
+    "containerNamespaces",
+    "readLogContainer",
+    "subscribeEvents",
+    "containerStatus",
+    "restoreContainer",
+    "containerIoStats",
+    "setCpuSetContainer",
+    "runRuntimeCommand",
+    "startContainer",
+    "serverLogConfig",
+    "listContainers",
+    "attachBuffer",
+    "capabilities",
+    "limits",
+    "updateContainer",
+];
+
+>>>>>>> acf1cda ([containers/conmon-rs#synth-773] fix: filter the container log on the server)
 macro_rules! pry_err {
     ($x:expr) => {
         pry!(capnp_err!($x))
@@ -85,7 +121,7 @@ impl conmon::Server for Server {
 
         let child_reaper = self.reaper().clone();
         let args = pry_err!(self.generate_runtime_args(&id, bundle_path, &container_io, &pidfile));
-        let runtime = self.config().runtime().clone();
+        let runtime = self.runtime_or_default(pry!(req.get_runtime()));
         let exit_paths: Vec<PathBuf> = pry!(pry!(req.get_exit_paths())
             .iter()
             .map(|r| r.map(PathBuf::from))
@@ -94,13 +130,15 @@ impl conmon::Server for Server {
             .iter()
             .map(|r| r.map(PathBuf::from))
             .collect());
+        let stdin = !req.get_close_stdio();
+        let exit_file_write_retries = req.get_exit_file_write_retries();
 
         Promise::from_future(
             async move {
                 capnp_err!(container_log.write().await.init().await)?;
 
                 let grandchild_pid = capnp_err!(match child_reaper
-                    .create_child(runtime, args, &mut container_io, &pidfile)
+                    .create_child(runtime, args, &mut container_io, &pidfile, stdin)
                     .await
                 {
                     Err(e) => {
@@ -118,7 +156,15 @@ impl conmon::Server for Server {
 
                 // register grandchild with server
                 let io = SharedContainerIO::new(container_io);
-                let child = Child::new(id, grandchild_pid, exit_paths, oom_exit_paths, None, io);
+                let child = Child::new(
+                    id,
+                    grandchild_pid,
+                    exit_paths,
+                    oom_exit_paths,
+                    None,
+                    exit_file_write_retries,
+                    io,
+                );
                 capnp_err!(child_reaper.watch_grandchild(child))?;
 
                 results
@@ -152,7 +198,7 @@ impl conmon::Server for Server {
 
         debug!("Got exec sync container request with timeout {}", timeout);
 
-        let runtime = self.config().runtime().clone();
+        let runtime = self.runtime_or_default(pry!(req.get_runtime()));
         let child_reaper = self.reaper().clone();
 
         let logger = ContainerLog::new();
@@ -164,7 +210,7 @@ impl conmon::Server for Server {
         Promise::from_future(
             async move {
                 match child_reaper
-                    .create_child(&runtime, &args, &mut container_io, &pidfile)
+                    .create_child(&runtime, &args, &mut container_io, &pidfile, true)
                     .await
                 {
                     Ok(grandchild_pid) => {
@@ -183,6 +229,7 @@ impl conmon::Server for Server {
                             vec![],
                             vec![],
                             time_to_timeout,
+                            0,
                             io_clone,
                         );
 
@@ -288,4 +335,132 @@ impl conmon::Server for Server {
                 .instrument(debug_span!("promise")),
         )
     }
+
+    /// Switch the path of a log driver for a running container.
+    fn swap_log_path(
+        &mut self,
+        params: conmon::SwapLogPathParams,
+        _: conmon::SwapLogPathResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("swap_log_path", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a swap log path request");
+
+        let child = pry_err!(self.reaper().get(container_id));
+        let path = PathBuf::from(pry!(req.get_path()));
+        let new_path = PathBuf::from(pry!(req.get_new_path()));
+
+        Promise::from_future(
+            async move {
+                capnp_err!(
+                    child
+                        .io()
+                        .logger()
+                        .await
+                        .write()
+                        .await
+                        .swap_path(&path, &new_path)
+                        .await
+                )
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve the namespaces of a running container.
+    fn container_namespaces(
+        &mut self,
+        params: conmon::ContainerNamespacesParams,
+        mut results: conmon::ContainerNamespacesResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("container_namespaces", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a container namespaces request");
+
+        let child = pry_err!(self.reaper().get(container_id));
+        let ns_dir = PathBuf::from(format!("/proc/{}/ns", child.pid()));
+
+        let mut namespaces = vec![];
+        for (name, typ) in [
+            ("cgroup", namespace::Type::Cgroup),
+            ("ipc", namespace::Type::Ipc),
+            ("mnt", namespace::Type::Mnt),
+            ("net", namespace::Type::Net),
+            ("pid", namespace::Type::Pid),
+            ("user", namespace::Type::User),
+            ("uts", namespace::Type::Uts),
+        ] {
+            match fs::read_link(ns_dir.join(name)) {
+                Ok(link) => namespaces.push((typ, link.display().to_string())),
+                Err(e) if e.kind() == ErrorKind::NotFound => {
+                    debug!("Namespace {} not supported", name)
+                }
+                Err(e) => {
+                    return Promise::err(Error::failed(format!(
+                        "read {} namespace link: {:#}",
+                        name, e
+                    )))
+                }
+            }
+        }
+
+        let mut list = results
+            .get()
+            .init_response()
+            .init_namespaces(namespaces.len() as u32);
+        for (i, (typ, link)) in namespaces.iter().enumerate() {
+            let mut namespace = list.reborrow().get(i as u32);
+            namespace.set_type(*typ);
+            namespace.set_link(link);
+        }
+
+        Promise::ok(())
+    }
+
+    /// Read the log of a container.
+    fn read_log_container(
+        &mut self,
+        params: conmon::ReadLogContainerParams,
+        mut results: conmon::ReadLogContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("read_log_container", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a read log container request");
+
+        let child = pry_err!(self.reaper().get(container_id));
+        let tail = req.get_tail();
+        let since = req.get_since_unix_nano();
+
+        Promise::from_future(
+            async move {
+                let (data, truncated) = capnp_err!(
+                    child
+                        .io()
+                        .logger()
+                        .await
+                        .write()
+                        .await
+                        .read(tail, since, MAX_READ_LOG_BYTES)
+                        .await
+                )?;
+                let mut response = results.get().init_response();
+                response.set_data(&data);
+                response.set_truncated(truncated);
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
 }
@@ -19,7 +19,14 @@ use nix::{
     sys::signal::Signal,
     unistd::{fork, ForkResult},
 };
-use std::{fs::File, io::Write, path::Path, process, str::FromStr, sync::Arc};
+use std::{
+    fs::File,
+    io::Write,
+    path::{Path, PathBuf},
+    process,
+    str::FromStr,
+    sync::Arc,
+};
 use tokio::{
     fs,
     runtime::{Builder, Handle},
@@ -222,6 +229,16 @@ impl Server {
         }
     }
 
+    /// Retrieve the OCI runtime binary path for the provided one, which falls back to the server
+    /// default if empty.
+    pub(crate) fn runtime_or_default(&self, runtime: &str) -> PathBuf {
+        if runtime.is_empty() {
+            self.config().runtime().clone()
+        } else {
+            runtime.into()
+        }
+    }
+
     /// Generate the OCI runtime CLI arguments from the provided parameters.
     pub(crate) fn generate_runtime_args(
         &self,
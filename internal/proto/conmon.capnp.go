@@ -111,6 +111,54 @@ func (c Conmon) SetWindowSizeContainer(ctx context.Context, params func(Conmon_s
 	ans, release := c.Client.SendCall(ctx, s)
 	return Conmon_setWindowSizeContainer_Results_Future{Future: ans.Future()}, release
 }
+func (c Conmon) SwapLogPath(ctx context.Context, params func(Conmon_swapLogPath_Params) error) (Conmon_swapLogPath_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      6,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "swapLogPath",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_swapLogPath_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_swapLogPath_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ContainerNamespaces(ctx context.Context, params func(Conmon_containerNamespaces_Params) error) (Conmon_containerNamespaces_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      7,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "containerNamespaces",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_containerNamespaces_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_containerNamespaces_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ReadLogContainer(ctx context.Context, params func(Conmon_readLogContainer_Params) error) (Conmon_readLogContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      8,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "readLogContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_readLogContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_readLogContainer_Results_Future{Future: ans.Future()}, release
+}
 
 func (c Conmon) AddRef() Conmon {
 	return Conmon{
@@ -135,6 +183,12 @@ type Conmon_Server interface {
 	ReopenLogContainer(context.Context, Conmon_reopenLogContainer) error
 
 	SetWindowSizeContainer(context.Context, Conmon_setWindowSizeContainer) error
+
+	SwapLogPath(context.Context, Conmon_swapLogPath) error
+
+	ContainerNamespaces(context.Context, Conmon_containerNamespaces) error
+
+	ReadLogContainer(context.Context, Conmon_readLogContainer) error
 }
 
 // Conmon_NewServer creates a new Server from an implementation of Conmon_Server.
@@ -153,7 +207,7 @@ func Conmon_ServerToClient(s Conmon_Server, policy *server.Policy) Conmon {
 // This can be used to create a more complicated Server.
 func Conmon_Methods(methods []server.Method, s Conmon_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 6)
+		methods = make([]server.Method, 0, 9)
 	}
 
 	methods = append(methods, server.Method{
@@ -228,6 +282,42 @@ func Conmon_Methods(methods []server.Method, s Conmon_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      6,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "swapLogPath",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SwapLogPath(ctx, Conmon_swapLogPath{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      7,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "containerNamespaces",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ContainerNamespaces(ctx, Conmon_containerNamespaces{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      8,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "readLogContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ReadLogContainer(ctx, Conmon_readLogContainer{call})
+		},
+	})
+
 	return methods
 }
 
@@ -333,6 +423,57 @@ func (c Conmon_setWindowSizeContainer) AllocResults() (Conmon_setWindowSizeConta
 	return Conmon_setWindowSizeContainer_Results{Struct: r}, err
 }
 
+// Conmon_swapLogPath holds the state for a server call to Conmon.swapLogPath.
+// See server.Call for documentation.
+type Conmon_swapLogPath struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_swapLogPath) Args() Conmon_swapLogPath_Params {
+	return Conmon_swapLogPath_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_swapLogPath) AllocResults() (Conmon_swapLogPath_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_swapLogPath_Results{Struct: r}, err
+}
+
+// Conmon_containerNamespaces holds the state for a server call to Conmon.containerNamespaces.
+// See server.Call for documentation.
+type Conmon_containerNamespaces struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_containerNamespaces) Args() Conmon_containerNamespaces_Params {
+	return Conmon_containerNamespaces_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_containerNamespaces) AllocResults() (Conmon_containerNamespaces_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerNamespaces_Results{Struct: r}, err
+}
+
+// Conmon_readLogContainer holds the state for a server call to Conmon.readLogContainer.
+// See server.Call for documentation.
+type Conmon_readLogContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_readLogContainer) Args() Conmon_readLogContainer_Params {
+	return Conmon_readLogContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_readLogContainer) AllocResults() (Conmon_readLogContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{Struct: r}, err
+}
+
 // Conmon_List is a list of Conmon.
 type Conmon_List = capnp.CapList[Conmon]
 
@@ -488,12 +629,12 @@ type Conmon_CreateContainerRequest struct{ capnp.Struct }
 const Conmon_CreateContainerRequest_TypeID = 0xba77e3fa3aa9b6ca
 
 func NewConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
 	return Conmon_CreateContainerRequest{st}, err
 }
 
 func NewRootConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
 	return Conmon_CreateContainerRequest{st}, err
 }
 
@@ -623,12 +764,46 @@ func (s Conmon_CreateContainerRequest) NewLogDrivers(n int32) (Conmon_LogDriver_
 	return l, err
 }
 
+func (s Conmon_CreateContainerRequest) CloseStdio() bool {
+	return s.Struct.Bit(1)
+}
+
+func (s Conmon_CreateContainerRequest) SetCloseStdio(v bool) {
+	s.Struct.SetBit(1, v)
+}
+
+func (s Conmon_CreateContainerRequest) Runtime() (string, error) {
+	p, err := s.Struct.Ptr(5)
+	return p.Text(), err
+}
+
+func (s Conmon_CreateContainerRequest) HasRuntime() bool {
+	return s.Struct.HasPtr(5)
+}
+
+func (s Conmon_CreateContainerRequest) RuntimeBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(5)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CreateContainerRequest) SetRuntime(v string) error {
+	return s.Struct.SetText(5, v)
+}
+
+func (s Conmon_CreateContainerRequest) ExitFileWriteRetries() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_CreateContainerRequest) SetExitFileWriteRetries(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
 // Conmon_CreateContainerRequest_List is a list of Conmon_CreateContainerRequest.
 type Conmon_CreateContainerRequest_List = capnp.StructList[Conmon_CreateContainerRequest]
 
 // NewConmon_CreateContainerRequest creates a new list of Conmon_CreateContainerRequest.
 func NewConmon_CreateContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_CreateContainerRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6}, sz)
 	return capnp.StructList[Conmon_CreateContainerRequest]{List: l}, err
 }
 
@@ -811,12 +986,12 @@ type Conmon_ExecSyncContainerRequest struct{ capnp.Struct }
 const Conmon_ExecSyncContainerRequest_TypeID = 0xf41122f890a371a6
 
 func NewConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Conmon_ExecSyncContainerRequest{st}, err
 }
 
 func NewRootConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Conmon_ExecSyncContainerRequest{st}, err
 }
 
@@ -888,12 +1063,30 @@ func (s Conmon_ExecSyncContainerRequest) SetTerminal(v bool) {
 	s.Struct.SetBit(64, v)
 }
 
+func (s Conmon_ExecSyncContainerRequest) Runtime() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasRuntime() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ExecSyncContainerRequest) RuntimeBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetRuntime(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
 // Conmon_ExecSyncContainerRequest_List is a list of Conmon_ExecSyncContainerRequest.
 type Conmon_ExecSyncContainerRequest_List = capnp.StructList[Conmon_ExecSyncContainerRequest]
 
 // NewConmon_ExecSyncContainerRequest creates a new list of Conmon_ExecSyncContainerRequest.
 func NewConmon_ExecSyncContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_ExecSyncContainerRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
 	return capnp.StructList[Conmon_ExecSyncContainerRequest]{List: l}, err
 }
 
@@ -1347,149 +1540,689 @@ func (p Conmon_SetWindowSizeResponse_Future) Struct() (Conmon_SetWindowSizeRespo
 	return Conmon_SetWindowSizeResponse{s}, err
 }
 
-type Conmon_version_Params struct{ capnp.Struct }
+type Conmon_SwapLogPathRequest struct{ capnp.Struct }
 
-// Conmon_version_Params_TypeID is the unique identifier for the type Conmon_version_Params.
-const Conmon_version_Params_TypeID = 0xcc2f70676afee4e7
+// Conmon_SwapLogPathRequest_TypeID is the unique identifier for the type Conmon_SwapLogPathRequest.
+const Conmon_SwapLogPathRequest_TypeID = 0x81a239eedba512c8
 
-func NewConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_version_Params{st}, err
+func NewConmon_SwapLogPathRequest(s *capnp.Segment) (Conmon_SwapLogPathRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_SwapLogPathRequest{st}, err
 }
 
-func NewRootConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_version_Params{st}, err
+func NewRootConmon_SwapLogPathRequest(s *capnp.Segment) (Conmon_SwapLogPathRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_SwapLogPathRequest{st}, err
 }
 
-func ReadRootConmon_version_Params(msg *capnp.Message) (Conmon_version_Params, error) {
+func ReadRootConmon_SwapLogPathRequest(msg *capnp.Message) (Conmon_SwapLogPathRequest, error) {
 	root, err := msg.Root()
-	return Conmon_version_Params{root.Struct()}, err
+	return Conmon_SwapLogPathRequest{root.Struct()}, err
 }
 
-func (s Conmon_version_Params) String() string {
-	str, _ := text.Marshal(0xcc2f70676afee4e7, s.Struct)
+func (s Conmon_SwapLogPathRequest) String() string {
+	str, _ := text.Marshal(0x81a239eedba512c8, s.Struct)
 	return str
 }
 
-// Conmon_version_Params_List is a list of Conmon_version_Params.
-type Conmon_version_Params_List = capnp.StructList[Conmon_version_Params]
+func (s Conmon_SwapLogPathRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
 
-// NewConmon_version_Params creates a new list of Conmon_version_Params.
-func NewConmon_version_Params_List(s *capnp.Segment, sz int32) (Conmon_version_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_version_Params]{List: l}, err
+func (s Conmon_SwapLogPathRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
 }
 
-// Conmon_version_Params_Future is a wrapper for a Conmon_version_Params promised by a client call.
-type Conmon_version_Params_Future struct{ *capnp.Future }
+func (s Conmon_SwapLogPathRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
 
-func (p Conmon_version_Params_Future) Struct() (Conmon_version_Params, error) {
-	s, err := p.Future.Struct()
-	return Conmon_version_Params{s}, err
+func (s Conmon_SwapLogPathRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
 }
 
-type Conmon_version_Results struct{ capnp.Struct }
+func (s Conmon_SwapLogPathRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
 
-// Conmon_version_Results_TypeID is the unique identifier for the type Conmon_version_Results.
-const Conmon_version_Results_TypeID = 0xe313695ea9477b30
+func (s Conmon_SwapLogPathRequest) HasPath() bool {
+	return s.Struct.HasPtr(1)
+}
 
-func NewConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_version_Results{st}, err
+func (s Conmon_SwapLogPathRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
 }
 
-func NewRootConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_version_Results{st}, err
+func (s Conmon_SwapLogPathRequest) SetPath(v string) error {
+	return s.Struct.SetText(1, v)
 }
 
-func ReadRootConmon_version_Results(msg *capnp.Message) (Conmon_version_Results, error) {
-	root, err := msg.Root()
-	return Conmon_version_Results{root.Struct()}, err
+func (s Conmon_SwapLogPathRequest) NewPath() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
 }
 
-func (s Conmon_version_Results) String() string {
-	str, _ := text.Marshal(0xe313695ea9477b30, s.Struct)
-	return str
+func (s Conmon_SwapLogPathRequest) HasNewPath() bool {
+	return s.Struct.HasPtr(2)
 }
 
-func (s Conmon_version_Results) Response() (Conmon_VersionResponse, error) {
-	p, err := s.Struct.Ptr(0)
-	return Conmon_VersionResponse{Struct: p.Struct()}, err
+func (s Conmon_SwapLogPathRequest) NewPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
 }
 
-func (s Conmon_version_Results) HasResponse() bool {
-	return s.Struct.HasPtr(0)
+func (s Conmon_SwapLogPathRequest) SetNewPath(v string) error {
+	return s.Struct.SetText(2, v)
 }
 
-func (s Conmon_version_Results) SetResponse(v Conmon_VersionResponse) error {
-	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+// Conmon_SwapLogPathRequest_List is a list of Conmon_SwapLogPathRequest.
+type Conmon_SwapLogPathRequest_List = capnp.StructList[Conmon_SwapLogPathRequest]
+
+// NewConmon_SwapLogPathRequest creates a new list of Conmon_SwapLogPathRequest.
+func NewConmon_SwapLogPathRequest_List(s *capnp.Segment, sz int32) (Conmon_SwapLogPathRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_SwapLogPathRequest]{List: l}, err
 }
 
-// NewResponse sets the response field to a newly
-// allocated Conmon_VersionResponse struct, preferring placement in s's segment.
-func (s Conmon_version_Results) NewResponse() (Conmon_VersionResponse, error) {
-	ss, err := NewConmon_VersionResponse(s.Struct.Segment())
-	if err != nil {
-		return Conmon_VersionResponse{}, err
-	}
-	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
-	return ss, err
+// Conmon_SwapLogPathRequest_Future is a wrapper for a Conmon_SwapLogPathRequest promised by a client call.
+type Conmon_SwapLogPathRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SwapLogPathRequest_Future) Struct() (Conmon_SwapLogPathRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SwapLogPathRequest{s}, err
 }
 
-// Conmon_version_Results_List is a list of Conmon_version_Results.
-type Conmon_version_Results_List = capnp.StructList[Conmon_version_Results]
+type Conmon_SwapLogPathResponse struct{ capnp.Struct }
 
-// NewConmon_version_Results creates a new list of Conmon_version_Results.
-func NewConmon_version_Results_List(s *capnp.Segment, sz int32) (Conmon_version_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_version_Results]{List: l}, err
+// Conmon_SwapLogPathResponse_TypeID is the unique identifier for the type Conmon_SwapLogPathResponse.
+const Conmon_SwapLogPathResponse_TypeID = 0x82bfbf84bbdf2850
+
+func NewConmon_SwapLogPathResponse(s *capnp.Segment) (Conmon_SwapLogPathResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SwapLogPathResponse{st}, err
 }
 
-// Conmon_version_Results_Future is a wrapper for a Conmon_version_Results promised by a client call.
-type Conmon_version_Results_Future struct{ *capnp.Future }
+func NewRootConmon_SwapLogPathResponse(s *capnp.Segment) (Conmon_SwapLogPathResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SwapLogPathResponse{st}, err
+}
 
-func (p Conmon_version_Results_Future) Struct() (Conmon_version_Results, error) {
-	s, err := p.Future.Struct()
-	return Conmon_version_Results{s}, err
+func ReadRootConmon_SwapLogPathResponse(msg *capnp.Message) (Conmon_SwapLogPathResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SwapLogPathResponse{root.Struct()}, err
 }
 
-func (p Conmon_version_Results_Future) Response() Conmon_VersionResponse_Future {
-	return Conmon_VersionResponse_Future{Future: p.Future.Field(0, nil)}
+func (s Conmon_SwapLogPathResponse) String() string {
+	str, _ := text.Marshal(0x82bfbf84bbdf2850, s.Struct)
+	return str
 }
 
-type Conmon_createContainer_Params struct{ capnp.Struct }
+// Conmon_SwapLogPathResponse_List is a list of Conmon_SwapLogPathResponse.
+type Conmon_SwapLogPathResponse_List = capnp.StructList[Conmon_SwapLogPathResponse]
 
-// Conmon_createContainer_Params_TypeID is the unique identifier for the type Conmon_createContainer_Params.
-const Conmon_createContainer_Params_TypeID = 0xf44732c48f949ab8
+// NewConmon_SwapLogPathResponse creates a new list of Conmon_SwapLogPathResponse.
+func NewConmon_SwapLogPathResponse_List(s *capnp.Segment, sz int32) (Conmon_SwapLogPathResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SwapLogPathResponse]{List: l}, err
+}
 
-func NewConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+// Conmon_SwapLogPathResponse_Future is a wrapper for a Conmon_SwapLogPathResponse promised by a client call.
+type Conmon_SwapLogPathResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SwapLogPathResponse_Future) Struct() (Conmon_SwapLogPathResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SwapLogPathResponse{s}, err
+}
+
+type Conmon_ContainerNamespacesRequest struct{ capnp.Struct }
+
+// Conmon_ContainerNamespacesRequest_TypeID is the unique identifier for the type Conmon_ContainerNamespacesRequest.
+const Conmon_ContainerNamespacesRequest_TypeID = 0xe32c2c8bfd0773d0
+
+func NewConmon_ContainerNamespacesRequest(s *capnp.Segment) (Conmon_ContainerNamespacesRequest, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Params{st}, err
+	return Conmon_ContainerNamespacesRequest{st}, err
 }
 
-func NewRootConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+func NewRootConmon_ContainerNamespacesRequest(s *capnp.Segment) (Conmon_ContainerNamespacesRequest, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Params{st}, err
+	return Conmon_ContainerNamespacesRequest{st}, err
 }
 
-func ReadRootConmon_createContainer_Params(msg *capnp.Message) (Conmon_createContainer_Params, error) {
+func ReadRootConmon_ContainerNamespacesRequest(msg *capnp.Message) (Conmon_ContainerNamespacesRequest, error) {
 	root, err := msg.Root()
-	return Conmon_createContainer_Params{root.Struct()}, err
+	return Conmon_ContainerNamespacesRequest{root.Struct()}, err
 }
 
-func (s Conmon_createContainer_Params) String() string {
-	str, _ := text.Marshal(0xf44732c48f949ab8, s.Struct)
+func (s Conmon_ContainerNamespacesRequest) String() string {
+	str, _ := text.Marshal(0xe32c2c8bfd0773d0, s.Struct)
 	return str
 }
 
-func (s Conmon_createContainer_Params) Request() (Conmon_CreateContainerRequest, error) {
+func (s Conmon_ContainerNamespacesRequest) Id() (string, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+	return p.Text(), err
 }
 
-func (s Conmon_createContainer_Params) HasRequest() bool {
+func (s Conmon_ContainerNamespacesRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerNamespacesRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerNamespacesRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_ContainerNamespacesRequest_List is a list of Conmon_ContainerNamespacesRequest.
+type Conmon_ContainerNamespacesRequest_List = capnp.StructList[Conmon_ContainerNamespacesRequest]
+
+// NewConmon_ContainerNamespacesRequest creates a new list of Conmon_ContainerNamespacesRequest.
+func NewConmon_ContainerNamespacesRequest_List(s *capnp.Segment, sz int32) (Conmon_ContainerNamespacesRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerNamespacesRequest]{List: l}, err
+}
+
+// Conmon_ContainerNamespacesRequest_Future is a wrapper for a Conmon_ContainerNamespacesRequest promised by a client call.
+type Conmon_ContainerNamespacesRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerNamespacesRequest_Future) Struct() (Conmon_ContainerNamespacesRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerNamespacesRequest{s}, err
+}
+
+type Conmon_Namespace struct{ capnp.Struct }
+
+// Conmon_Namespace_TypeID is the unique identifier for the type Conmon_Namespace.
+const Conmon_Namespace_TypeID = 0xd61491b560a8f3a3
+
+func NewConmon_Namespace(s *capnp.Segment) (Conmon_Namespace, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_Namespace{st}, err
+}
+
+func NewRootConmon_Namespace(s *capnp.Segment) (Conmon_Namespace, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_Namespace{st}, err
+}
+
+func ReadRootConmon_Namespace(msg *capnp.Message) (Conmon_Namespace, error) {
+	root, err := msg.Root()
+	return Conmon_Namespace{root.Struct()}, err
+}
+
+func (s Conmon_Namespace) String() string {
+	str, _ := text.Marshal(0xd61491b560a8f3a3, s.Struct)
+	return str
+}
+
+func (s Conmon_Namespace) Type() Conmon_Namespace_Type {
+	return Conmon_Namespace_Type(s.Struct.Uint16(0))
+}
+
+func (s Conmon_Namespace) SetType(v Conmon_Namespace_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_Namespace) Link() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_Namespace) HasLink() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_Namespace) LinkBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Namespace) SetLink(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_Namespace_List is a list of Conmon_Namespace.
+type Conmon_Namespace_List = capnp.StructList[Conmon_Namespace]
+
+// NewConmon_Namespace creates a new list of Conmon_Namespace.
+func NewConmon_Namespace_List(s *capnp.Segment, sz int32) (Conmon_Namespace_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_Namespace]{List: l}, err
+}
+
+// Conmon_Namespace_Future is a wrapper for a Conmon_Namespace promised by a client call.
+type Conmon_Namespace_Future struct{ *capnp.Future }
+
+func (p Conmon_Namespace_Future) Struct() (Conmon_Namespace, error) {
+	s, err := p.Future.Struct()
+	return Conmon_Namespace{s}, err
+}
+
+type Conmon_Namespace_Type uint16
+
+// Conmon_Namespace_Type_TypeID is the unique identifier for the type Conmon_Namespace_Type.
+const Conmon_Namespace_Type_TypeID = 0xdedbd323fc140cfd
+
+// Values of Conmon_Namespace_Type.
+const (
+	Conmon_Namespace_Type_cgroup Conmon_Namespace_Type = 0
+	Conmon_Namespace_Type_ipc    Conmon_Namespace_Type = 1
+	Conmon_Namespace_Type_mnt    Conmon_Namespace_Type = 2
+	Conmon_Namespace_Type_net    Conmon_Namespace_Type = 3
+	Conmon_Namespace_Type_pid    Conmon_Namespace_Type = 4
+	Conmon_Namespace_Type_user   Conmon_Namespace_Type = 5
+	Conmon_Namespace_Type_uts    Conmon_Namespace_Type = 6
+)
+
+// String returns the enum's constant name.
+func (c Conmon_Namespace_Type) String() string {
+	switch c {
+	case Conmon_Namespace_Type_cgroup:
+		return "cgroup"
+	case Conmon_Namespace_Type_ipc:
+		return "ipc"
+	case Conmon_Namespace_Type_mnt:
+		return "mnt"
+	case Conmon_Namespace_Type_net:
+		return "net"
+	case Conmon_Namespace_Type_pid:
+		return "pid"
+	case Conmon_Namespace_Type_user:
+		return "user"
+	case Conmon_Namespace_Type_uts:
+		return "uts"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_Namespace_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_Namespace_TypeFromString(c string) Conmon_Namespace_Type {
+	switch c {
+	case "cgroup":
+		return Conmon_Namespace_Type_cgroup
+	case "ipc":
+		return Conmon_Namespace_Type_ipc
+	case "mnt":
+		return Conmon_Namespace_Type_mnt
+	case "net":
+		return Conmon_Namespace_Type_net
+	case "pid":
+		return Conmon_Namespace_Type_pid
+	case "user":
+		return Conmon_Namespace_Type_user
+	case "uts":
+		return Conmon_Namespace_Type_uts
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_Namespace_Type_List = capnp.EnumList[Conmon_Namespace_Type]
+
+func NewConmon_Namespace_Type_List(s *capnp.Segment, sz int32) (Conmon_Namespace_Type_List, error) {
+	return capnp.NewEnumList[Conmon_Namespace_Type](s, sz)
+}
+
+type Conmon_ContainerNamespacesResponse struct{ capnp.Struct }
+
+// Conmon_ContainerNamespacesResponse_TypeID is the unique identifier for the type Conmon_ContainerNamespacesResponse.
+const Conmon_ContainerNamespacesResponse_TypeID = 0xcdd84e02c7acf641
+
+func NewConmon_ContainerNamespacesResponse(s *capnp.Segment) (Conmon_ContainerNamespacesResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerNamespacesResponse{st}, err
+}
+
+func NewRootConmon_ContainerNamespacesResponse(s *capnp.Segment) (Conmon_ContainerNamespacesResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerNamespacesResponse{st}, err
+}
+
+func ReadRootConmon_ContainerNamespacesResponse(msg *capnp.Message) (Conmon_ContainerNamespacesResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerNamespacesResponse{root.Struct()}, err
+}
+
+func (s Conmon_ContainerNamespacesResponse) String() string {
+	str, _ := text.Marshal(0xcdd84e02c7acf641, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerNamespacesResponse) Namespaces() (Conmon_Namespace_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_Namespace_List{List: p.List()}, err
+}
+
+func (s Conmon_ContainerNamespacesResponse) HasNamespaces() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerNamespacesResponse) SetNamespaces(v Conmon_Namespace_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewNamespaces sets the namespaces field to a newly
+// allocated Conmon_Namespace_List, preferring placement in s's segment.
+func (s Conmon_ContainerNamespacesResponse) NewNamespaces(n int32) (Conmon_Namespace_List, error) {
+	l, err := NewConmon_Namespace_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Namespace_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ContainerNamespacesResponse_List is a list of Conmon_ContainerNamespacesResponse.
+type Conmon_ContainerNamespacesResponse_List = capnp.StructList[Conmon_ContainerNamespacesResponse]
+
+// NewConmon_ContainerNamespacesResponse creates a new list of Conmon_ContainerNamespacesResponse.
+func NewConmon_ContainerNamespacesResponse_List(s *capnp.Segment, sz int32) (Conmon_ContainerNamespacesResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerNamespacesResponse]{List: l}, err
+}
+
+// Conmon_ContainerNamespacesResponse_Future is a wrapper for a Conmon_ContainerNamespacesResponse promised by a client call.
+type Conmon_ContainerNamespacesResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerNamespacesResponse_Future) Struct() (Conmon_ContainerNamespacesResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerNamespacesResponse{s}, err
+}
+
+type Conmon_ReadLogRequest struct{ capnp.Struct }
+
+// Conmon_ReadLogRequest_TypeID is the unique identifier for the type Conmon_ReadLogRequest.
+const Conmon_ReadLogRequest_TypeID = 0x9b0d278358e9d418
+
+func NewConmon_ReadLogRequest(s *capnp.Segment) (Conmon_ReadLogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_ReadLogRequest{st}, err
+}
+
+func NewRootConmon_ReadLogRequest(s *capnp.Segment) (Conmon_ReadLogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_ReadLogRequest{st}, err
+}
+
+func ReadRootConmon_ReadLogRequest(msg *capnp.Message) (Conmon_ReadLogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ReadLogRequest{root.Struct()}, err
+}
+
+func (s Conmon_ReadLogRequest) String() string {
+	str, _ := text.Marshal(0x9b0d278358e9d418, s.Struct)
+	return str
+}
+
+func (s Conmon_ReadLogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ReadLogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ReadLogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReadLogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ReadLogRequest) Tail() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ReadLogRequest) SetTail(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ReadLogRequest) SinceUnixNano() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Conmon_ReadLogRequest) SetSinceUnixNano(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+// Conmon_ReadLogRequest_List is a list of Conmon_ReadLogRequest.
+type Conmon_ReadLogRequest_List = capnp.StructList[Conmon_ReadLogRequest]
+
+// NewConmon_ReadLogRequest creates a new list of Conmon_ReadLogRequest.
+func NewConmon_ReadLogRequest_List(s *capnp.Segment, sz int32) (Conmon_ReadLogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ReadLogRequest]{List: l}, err
+}
+
+// Conmon_ReadLogRequest_Future is a wrapper for a Conmon_ReadLogRequest promised by a client call.
+type Conmon_ReadLogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ReadLogRequest_Future) Struct() (Conmon_ReadLogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReadLogRequest{s}, err
+}
+
+type Conmon_ReadLogResponse struct{ capnp.Struct }
+
+// Conmon_ReadLogResponse_TypeID is the unique identifier for the type Conmon_ReadLogResponse.
+const Conmon_ReadLogResponse_TypeID = 0xebbc7ae7ae262bb9
+
+func NewConmon_ReadLogResponse(s *capnp.Segment) (Conmon_ReadLogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_ReadLogResponse{st}, err
+}
+
+func NewRootConmon_ReadLogResponse(s *capnp.Segment) (Conmon_ReadLogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_ReadLogResponse{st}, err
+}
+
+func ReadRootConmon_ReadLogResponse(msg *capnp.Message) (Conmon_ReadLogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ReadLogResponse{root.Struct()}, err
+}
+
+func (s Conmon_ReadLogResponse) String() string {
+	str, _ := text.Marshal(0xebbc7ae7ae262bb9, s.Struct)
+	return str
+}
+
+func (s Conmon_ReadLogResponse) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Conmon_ReadLogResponse) HasData() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ReadLogResponse) SetData(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s Conmon_ReadLogResponse) Truncated() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_ReadLogResponse) SetTruncated(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+// Conmon_ReadLogResponse_List is a list of Conmon_ReadLogResponse.
+type Conmon_ReadLogResponse_List = capnp.StructList[Conmon_ReadLogResponse]
+
+// NewConmon_ReadLogResponse creates a new list of Conmon_ReadLogResponse.
+func NewConmon_ReadLogResponse_List(s *capnp.Segment, sz int32) (Conmon_ReadLogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ReadLogResponse]{List: l}, err
+}
+
+// Conmon_ReadLogResponse_Future is a wrapper for a Conmon_ReadLogResponse promised by a client call.
+type Conmon_ReadLogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ReadLogResponse_Future) Struct() (Conmon_ReadLogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReadLogResponse{s}, err
+}
+
+type Conmon_version_Params struct{ capnp.Struct }
+
+// Conmon_version_Params_TypeID is the unique identifier for the type Conmon_version_Params.
+const Conmon_version_Params_TypeID = 0xcc2f70676afee4e7
+
+func NewConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_version_Params{st}, err
+}
+
+func NewRootConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_version_Params{st}, err
+}
+
+func ReadRootConmon_version_Params(msg *capnp.Message) (Conmon_version_Params, error) {
+	root, err := msg.Root()
+	return Conmon_version_Params{root.Struct()}, err
+}
+
+func (s Conmon_version_Params) String() string {
+	str, _ := text.Marshal(0xcc2f70676afee4e7, s.Struct)
+	return str
+}
+
+// Conmon_version_Params_List is a list of Conmon_version_Params.
+type Conmon_version_Params_List = capnp.StructList[Conmon_version_Params]
+
+// NewConmon_version_Params creates a new list of Conmon_version_Params.
+func NewConmon_version_Params_List(s *capnp.Segment, sz int32) (Conmon_version_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_version_Params]{List: l}, err
+}
+
+// Conmon_version_Params_Future is a wrapper for a Conmon_version_Params promised by a client call.
+type Conmon_version_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_version_Params_Future) Struct() (Conmon_version_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_version_Params{s}, err
+}
+
+type Conmon_version_Results struct{ capnp.Struct }
+
+// Conmon_version_Results_TypeID is the unique identifier for the type Conmon_version_Results.
+const Conmon_version_Results_TypeID = 0xe313695ea9477b30
+
+func NewConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_version_Results{st}, err
+}
+
+func NewRootConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_version_Results{st}, err
+}
+
+func ReadRootConmon_version_Results(msg *capnp.Message) (Conmon_version_Results, error) {
+	root, err := msg.Root()
+	return Conmon_version_Results{root.Struct()}, err
+}
+
+func (s Conmon_version_Results) String() string {
+	str, _ := text.Marshal(0xe313695ea9477b30, s.Struct)
+	return str
+}
+
+func (s Conmon_version_Results) Response() (Conmon_VersionResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_VersionResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_version_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_version_Results) SetResponse(v Conmon_VersionResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_VersionResponse struct, preferring placement in s's segment.
+func (s Conmon_version_Results) NewResponse() (Conmon_VersionResponse, error) {
+	ss, err := NewConmon_VersionResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_VersionResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_version_Results_List is a list of Conmon_version_Results.
+type Conmon_version_Results_List = capnp.StructList[Conmon_version_Results]
+
+// NewConmon_version_Results creates a new list of Conmon_version_Results.
+func NewConmon_version_Results_List(s *capnp.Segment, sz int32) (Conmon_version_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_version_Results]{List: l}, err
+}
+
+// Conmon_version_Results_Future is a wrapper for a Conmon_version_Results promised by a client call.
+type Conmon_version_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_version_Results_Future) Struct() (Conmon_version_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_version_Results{s}, err
+}
+
+func (p Conmon_version_Results_Future) Response() Conmon_VersionResponse_Future {
+	return Conmon_VersionResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_createContainer_Params struct{ capnp.Struct }
+
+// Conmon_createContainer_Params_TypeID is the unique identifier for the type Conmon_createContainer_Params.
+const Conmon_createContainer_Params_TypeID = 0xf44732c48f949ab8
+
+func NewConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Params{st}, err
+}
+
+func NewRootConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Params{st}, err
+}
+
+func ReadRootConmon_createContainer_Params(msg *capnp.Message) (Conmon_createContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_createContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_createContainer_Params) String() string {
+	str, _ := text.Marshal(0xf44732c48f949ab8, s.Struct)
+	return str
+}
+
+func (s Conmon_createContainer_Params) Request() (Conmon_CreateContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_createContainer_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
@@ -1498,795 +2231,3473 @@ func (s Conmon_createContainer_Params) SetRequest(v Conmon_CreateContainerReques
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
-func (s Conmon_createContainer_Params) NewRequest() (Conmon_CreateContainerRequest, error) {
-	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_createContainer_Params) NewRequest() (Conmon_CreateContainerRequest, error) {
+	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_createContainer_Params_List is a list of Conmon_createContainer_Params.
+type Conmon_createContainer_Params_List = capnp.StructList[Conmon_createContainer_Params]
+
+// NewConmon_createContainer_Params creates a new list of Conmon_createContainer_Params.
+func NewConmon_createContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_createContainer_Params]{List: l}, err
+}
+
+// Conmon_createContainer_Params_Future is a wrapper for a Conmon_createContainer_Params promised by a client call.
+type Conmon_createContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_createContainer_Params_Future) Struct() (Conmon_createContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_createContainer_Params{s}, err
+}
+
+func (p Conmon_createContainer_Params_Future) Request() Conmon_CreateContainerRequest_Future {
+	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_createContainer_Results struct{ capnp.Struct }
+
+// Conmon_createContainer_Results_TypeID is the unique identifier for the type Conmon_createContainer_Results.
+const Conmon_createContainer_Results_TypeID = 0xceba3c1a97be15f8
+
+func NewConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Results{st}, err
+}
+
+func NewRootConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Results{st}, err
+}
+
+func ReadRootConmon_createContainer_Results(msg *capnp.Message) (Conmon_createContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_createContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_createContainer_Results) String() string {
+	str, _ := text.Marshal(0xceba3c1a97be15f8, s.Struct)
+	return str
+}
+
+func (s Conmon_createContainer_Results) Response() (Conmon_CreateContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_createContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_createContainer_Results) SetResponse(v Conmon_CreateContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_CreateContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_createContainer_Results) NewResponse() (Conmon_CreateContainerResponse, error) {
+	ss, err := NewConmon_CreateContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_createContainer_Results_List is a list of Conmon_createContainer_Results.
+type Conmon_createContainer_Results_List = capnp.StructList[Conmon_createContainer_Results]
+
+// NewConmon_createContainer_Results creates a new list of Conmon_createContainer_Results.
+func NewConmon_createContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_createContainer_Results]{List: l}, err
+}
+
+// Conmon_createContainer_Results_Future is a wrapper for a Conmon_createContainer_Results promised by a client call.
+type Conmon_createContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_createContainer_Results_Future) Struct() (Conmon_createContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_createContainer_Results{s}, err
+}
+
+func (p Conmon_createContainer_Results_Future) Response() Conmon_CreateContainerResponse_Future {
+	return Conmon_CreateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execSyncContainer_Params struct{ capnp.Struct }
+
+// Conmon_execSyncContainer_Params_TypeID is the unique identifier for the type Conmon_execSyncContainer_Params.
+const Conmon_execSyncContainer_Params_TypeID = 0x83479da67279e173
+
+func NewConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Params{st}, err
+}
+
+func NewRootConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Params{st}, err
+}
+
+func ReadRootConmon_execSyncContainer_Params(msg *capnp.Message) (Conmon_execSyncContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_execSyncContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Params) String() string {
+	str, _ := text.Marshal(0x83479da67279e173, s.Struct)
+	return str
+}
+
+func (s Conmon_execSyncContainer_Params) Request() (Conmon_ExecSyncContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecSyncContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execSyncContainer_Params) SetRequest(v Conmon_ExecSyncContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ExecSyncContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_execSyncContainer_Params) NewRequest() (Conmon_ExecSyncContainerRequest, error) {
+	ss, err := NewConmon_ExecSyncContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecSyncContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execSyncContainer_Params_List is a list of Conmon_execSyncContainer_Params.
+type Conmon_execSyncContainer_Params_List = capnp.StructList[Conmon_execSyncContainer_Params]
+
+// NewConmon_execSyncContainer_Params creates a new list of Conmon_execSyncContainer_Params.
+func NewConmon_execSyncContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execSyncContainer_Params]{List: l}, err
+}
+
+// Conmon_execSyncContainer_Params_Future is a wrapper for a Conmon_execSyncContainer_Params promised by a client call.
+type Conmon_execSyncContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_execSyncContainer_Params_Future) Struct() (Conmon_execSyncContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execSyncContainer_Params{s}, err
+}
+
+func (p Conmon_execSyncContainer_Params_Future) Request() Conmon_ExecSyncContainerRequest_Future {
+	return Conmon_ExecSyncContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execSyncContainer_Results struct{ capnp.Struct }
+
+// Conmon_execSyncContainer_Results_TypeID is the unique identifier for the type Conmon_execSyncContainer_Results.
+const Conmon_execSyncContainer_Results_TypeID = 0xf8e86a5c0baa01bc
+
+func NewConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Results{st}, err
+}
+
+func NewRootConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Results{st}, err
+}
+
+func ReadRootConmon_execSyncContainer_Results(msg *capnp.Message) (Conmon_execSyncContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_execSyncContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Results) String() string {
+	str, _ := text.Marshal(0xf8e86a5c0baa01bc, s.Struct)
+	return str
+}
+
+func (s Conmon_execSyncContainer_Results) Response() (Conmon_ExecSyncContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecSyncContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execSyncContainer_Results) SetResponse(v Conmon_ExecSyncContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ExecSyncContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_execSyncContainer_Results) NewResponse() (Conmon_ExecSyncContainerResponse, error) {
+	ss, err := NewConmon_ExecSyncContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecSyncContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execSyncContainer_Results_List is a list of Conmon_execSyncContainer_Results.
+type Conmon_execSyncContainer_Results_List = capnp.StructList[Conmon_execSyncContainer_Results]
+
+// NewConmon_execSyncContainer_Results creates a new list of Conmon_execSyncContainer_Results.
+func NewConmon_execSyncContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execSyncContainer_Results]{List: l}, err
+}
+
+// Conmon_execSyncContainer_Results_Future is a wrapper for a Conmon_execSyncContainer_Results promised by a client call.
+type Conmon_execSyncContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_execSyncContainer_Results_Future) Struct() (Conmon_execSyncContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execSyncContainer_Results{s}, err
+}
+
+func (p Conmon_execSyncContainer_Results_Future) Response() Conmon_ExecSyncContainerResponse_Future {
+	return Conmon_ExecSyncContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_attachContainer_Params struct{ capnp.Struct }
+
+// Conmon_attachContainer_Params_TypeID is the unique identifier for the type Conmon_attachContainer_Params.
+const Conmon_attachContainer_Params_TypeID = 0xaa2f3c8ad1c3af24
+
+func NewConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Params{st}, err
+}
+
+func NewRootConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Params{st}, err
+}
+
+func ReadRootConmon_attachContainer_Params(msg *capnp.Message) (Conmon_attachContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_attachContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Params) String() string {
+	str, _ := text.Marshal(0xaa2f3c8ad1c3af24, s.Struct)
+	return str
+}
+
+func (s Conmon_attachContainer_Params) Request() (Conmon_AttachRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_AttachRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_attachContainer_Params) SetRequest(v Conmon_AttachRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_AttachRequest struct, preferring placement in s's segment.
+func (s Conmon_attachContainer_Params) NewRequest() (Conmon_AttachRequest, error) {
+	ss, err := NewConmon_AttachRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_AttachRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_attachContainer_Params_List is a list of Conmon_attachContainer_Params.
+type Conmon_attachContainer_Params_List = capnp.StructList[Conmon_attachContainer_Params]
+
+// NewConmon_attachContainer_Params creates a new list of Conmon_attachContainer_Params.
+func NewConmon_attachContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_attachContainer_Params]{List: l}, err
+}
+
+// Conmon_attachContainer_Params_Future is a wrapper for a Conmon_attachContainer_Params promised by a client call.
+type Conmon_attachContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_attachContainer_Params_Future) Struct() (Conmon_attachContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_attachContainer_Params{s}, err
+}
+
+func (p Conmon_attachContainer_Params_Future) Request() Conmon_AttachRequest_Future {
+	return Conmon_AttachRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_attachContainer_Results struct{ capnp.Struct }
+
+// Conmon_attachContainer_Results_TypeID is the unique identifier for the type Conmon_attachContainer_Results.
+const Conmon_attachContainer_Results_TypeID = 0xc5e65eec3dcf5b10
+
+func NewConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Results{st}, err
+}
+
+func NewRootConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Results{st}, err
+}
+
+func ReadRootConmon_attachContainer_Results(msg *capnp.Message) (Conmon_attachContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_attachContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Results) String() string {
+	str, _ := text.Marshal(0xc5e65eec3dcf5b10, s.Struct)
+	return str
+}
+
+func (s Conmon_attachContainer_Results) Response() (Conmon_AttachResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_AttachResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_attachContainer_Results) SetResponse(v Conmon_AttachResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_AttachResponse struct, preferring placement in s's segment.
+func (s Conmon_attachContainer_Results) NewResponse() (Conmon_AttachResponse, error) {
+	ss, err := NewConmon_AttachResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_AttachResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_attachContainer_Results_List is a list of Conmon_attachContainer_Results.
+type Conmon_attachContainer_Results_List = capnp.StructList[Conmon_attachContainer_Results]
+
+// NewConmon_attachContainer_Results creates a new list of Conmon_attachContainer_Results.
+func NewConmon_attachContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_attachContainer_Results]{List: l}, err
+}
+
+// Conmon_attachContainer_Results_Future is a wrapper for a Conmon_attachContainer_Results promised by a client call.
+type Conmon_attachContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_attachContainer_Results_Future) Struct() (Conmon_attachContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_attachContainer_Results{s}, err
+}
+
+func (p Conmon_attachContainer_Results_Future) Response() Conmon_AttachResponse_Future {
+	return Conmon_AttachResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_reopenLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_reopenLogContainer_Params_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Params.
+const Conmon_reopenLogContainer_Params_TypeID = 0xe5ea916eb0c31336
+
+func NewConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Params{st}, err
+}
+
+func NewRootConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_reopenLogContainer_Params(msg *capnp.Message) (Conmon_reopenLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_reopenLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Params) String() string {
+	str, _ := text.Marshal(0xe5ea916eb0c31336, s.Struct)
+	return str
+}
+
+func (s Conmon_reopenLogContainer_Params) Request() (Conmon_ReopenLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReopenLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_reopenLogContainer_Params) SetRequest(v Conmon_ReopenLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ReopenLogRequest struct, preferring placement in s's segment.
+func (s Conmon_reopenLogContainer_Params) NewRequest() (Conmon_ReopenLogRequest, error) {
+	ss, err := NewConmon_ReopenLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReopenLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_reopenLogContainer_Params_List is a list of Conmon_reopenLogContainer_Params.
+type Conmon_reopenLogContainer_Params_List = capnp.StructList[Conmon_reopenLogContainer_Params]
+
+// NewConmon_reopenLogContainer_Params creates a new list of Conmon_reopenLogContainer_Params.
+func NewConmon_reopenLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_reopenLogContainer_Params]{List: l}, err
+}
+
+// Conmon_reopenLogContainer_Params_Future is a wrapper for a Conmon_reopenLogContainer_Params promised by a client call.
+type Conmon_reopenLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_reopenLogContainer_Params_Future) Struct() (Conmon_reopenLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_reopenLogContainer_Params{s}, err
+}
+
+func (p Conmon_reopenLogContainer_Params_Future) Request() Conmon_ReopenLogRequest_Future {
+	return Conmon_ReopenLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_reopenLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_reopenLogContainer_Results_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Results.
+const Conmon_reopenLogContainer_Results_TypeID = 0xa0ef8355b64ee985
+
+func NewConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Results{st}, err
+}
+
+func NewRootConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_reopenLogContainer_Results(msg *capnp.Message) (Conmon_reopenLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_reopenLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Results) String() string {
+	str, _ := text.Marshal(0xa0ef8355b64ee985, s.Struct)
+	return str
+}
+
+func (s Conmon_reopenLogContainer_Results) Response() (Conmon_ReopenLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReopenLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_reopenLogContainer_Results) SetResponse(v Conmon_ReopenLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ReopenLogResponse struct, preferring placement in s's segment.
+func (s Conmon_reopenLogContainer_Results) NewResponse() (Conmon_ReopenLogResponse, error) {
+	ss, err := NewConmon_ReopenLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReopenLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_reopenLogContainer_Results_List is a list of Conmon_reopenLogContainer_Results.
+type Conmon_reopenLogContainer_Results_List = capnp.StructList[Conmon_reopenLogContainer_Results]
+
+// NewConmon_reopenLogContainer_Results creates a new list of Conmon_reopenLogContainer_Results.
+func NewConmon_reopenLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_reopenLogContainer_Results]{List: l}, err
+}
+
+// Conmon_reopenLogContainer_Results_Future is a wrapper for a Conmon_reopenLogContainer_Results promised by a client call.
+type Conmon_reopenLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_reopenLogContainer_Results_Future) Struct() (Conmon_reopenLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_reopenLogContainer_Results{s}, err
+}
+
+func (p Conmon_reopenLogContainer_Results_Future) Response() Conmon_ReopenLogResponse_Future {
+	return Conmon_ReopenLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWindowSizeContainer_Params struct{ capnp.Struct }
+
+// Conmon_setWindowSizeContainer_Params_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Params.
+const Conmon_setWindowSizeContainer_Params_TypeID = 0xc76ccd4502bb61e7
+
+func NewConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Params{st}, err
+}
+
+func NewRootConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Params{st}, err
+}
+
+func ReadRootConmon_setWindowSizeContainer_Params(msg *capnp.Message) (Conmon_setWindowSizeContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_setWindowSizeContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Params) String() string {
+	str, _ := text.Marshal(0xc76ccd4502bb61e7, s.Struct)
+	return str
+}
+
+func (s Conmon_setWindowSizeContainer_Params) Request() (Conmon_SetWindowSizeRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWindowSizeRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWindowSizeContainer_Params) SetRequest(v Conmon_SetWindowSizeRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SetWindowSizeRequest struct, preferring placement in s's segment.
+func (s Conmon_setWindowSizeContainer_Params) NewRequest() (Conmon_SetWindowSizeRequest, error) {
+	ss, err := NewConmon_SetWindowSizeRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWindowSizeRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWindowSizeContainer_Params_List is a list of Conmon_setWindowSizeContainer_Params.
+type Conmon_setWindowSizeContainer_Params_List = capnp.StructList[Conmon_setWindowSizeContainer_Params]
+
+// NewConmon_setWindowSizeContainer_Params creates a new list of Conmon_setWindowSizeContainer_Params.
+func NewConmon_setWindowSizeContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWindowSizeContainer_Params]{List: l}, err
+}
+
+// Conmon_setWindowSizeContainer_Params_Future is a wrapper for a Conmon_setWindowSizeContainer_Params promised by a client call.
+type Conmon_setWindowSizeContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_setWindowSizeContainer_Params_Future) Struct() (Conmon_setWindowSizeContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWindowSizeContainer_Params{s}, err
+}
+
+func (p Conmon_setWindowSizeContainer_Params_Future) Request() Conmon_SetWindowSizeRequest_Future {
+	return Conmon_SetWindowSizeRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWindowSizeContainer_Results struct{ capnp.Struct }
+
+// Conmon_setWindowSizeContainer_Results_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Results.
+const Conmon_setWindowSizeContainer_Results_TypeID = 0xe00e522611477055
+
+func NewConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Results{st}, err
+}
+
+func NewRootConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Results{st}, err
+}
+
+func ReadRootConmon_setWindowSizeContainer_Results(msg *capnp.Message) (Conmon_setWindowSizeContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_setWindowSizeContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Results) String() string {
+	str, _ := text.Marshal(0xe00e522611477055, s.Struct)
+	return str
+}
+
+func (s Conmon_setWindowSizeContainer_Results) Response() (Conmon_SetWindowSizeResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWindowSizeResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWindowSizeContainer_Results) SetResponse(v Conmon_SetWindowSizeResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SetWindowSizeResponse struct, preferring placement in s's segment.
+func (s Conmon_setWindowSizeContainer_Results) NewResponse() (Conmon_SetWindowSizeResponse, error) {
+	ss, err := NewConmon_SetWindowSizeResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWindowSizeResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWindowSizeContainer_Results_List is a list of Conmon_setWindowSizeContainer_Results.
+type Conmon_setWindowSizeContainer_Results_List = capnp.StructList[Conmon_setWindowSizeContainer_Results]
+
+// NewConmon_setWindowSizeContainer_Results creates a new list of Conmon_setWindowSizeContainer_Results.
+func NewConmon_setWindowSizeContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWindowSizeContainer_Results]{List: l}, err
+}
+
+// Conmon_setWindowSizeContainer_Results_Future is a wrapper for a Conmon_setWindowSizeContainer_Results promised by a client call.
+type Conmon_setWindowSizeContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_setWindowSizeContainer_Results_Future) Struct() (Conmon_setWindowSizeContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWindowSizeContainer_Results{s}, err
+}
+
+func (p Conmon_setWindowSizeContainer_Results_Future) Response() Conmon_SetWindowSizeResponse_Future {
+	return Conmon_SetWindowSizeResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_swapLogPath_Params struct{ capnp.Struct }
+
+// Conmon_swapLogPath_Params_TypeID is the unique identifier for the type Conmon_swapLogPath_Params.
+const Conmon_swapLogPath_Params_TypeID = 0x8b4c03a0662a38dc
+
+func NewConmon_swapLogPath_Params(s *capnp.Segment) (Conmon_swapLogPath_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_swapLogPath_Params{st}, err
+}
+
+func NewRootConmon_swapLogPath_Params(s *capnp.Segment) (Conmon_swapLogPath_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_swapLogPath_Params{st}, err
+}
+
+func ReadRootConmon_swapLogPath_Params(msg *capnp.Message) (Conmon_swapLogPath_Params, error) {
+	root, err := msg.Root()
+	return Conmon_swapLogPath_Params{root.Struct()}, err
+}
+
+func (s Conmon_swapLogPath_Params) String() string {
+	str, _ := text.Marshal(0x8b4c03a0662a38dc, s.Struct)
+	return str
+}
+
+func (s Conmon_swapLogPath_Params) Request() (Conmon_SwapLogPathRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SwapLogPathRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_swapLogPath_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_swapLogPath_Params) SetRequest(v Conmon_SwapLogPathRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SwapLogPathRequest struct, preferring placement in s's segment.
+func (s Conmon_swapLogPath_Params) NewRequest() (Conmon_SwapLogPathRequest, error) {
+	ss, err := NewConmon_SwapLogPathRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SwapLogPathRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_swapLogPath_Params_List is a list of Conmon_swapLogPath_Params.
+type Conmon_swapLogPath_Params_List = capnp.StructList[Conmon_swapLogPath_Params]
+
+// NewConmon_swapLogPath_Params creates a new list of Conmon_swapLogPath_Params.
+func NewConmon_swapLogPath_Params_List(s *capnp.Segment, sz int32) (Conmon_swapLogPath_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_swapLogPath_Params]{List: l}, err
+}
+
+// Conmon_swapLogPath_Params_Future is a wrapper for a Conmon_swapLogPath_Params promised by a client call.
+type Conmon_swapLogPath_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_swapLogPath_Params_Future) Struct() (Conmon_swapLogPath_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_swapLogPath_Params{s}, err
+}
+
+func (p Conmon_swapLogPath_Params_Future) Request() Conmon_SwapLogPathRequest_Future {
+	return Conmon_SwapLogPathRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_swapLogPath_Results struct{ capnp.Struct }
+
+// Conmon_swapLogPath_Results_TypeID is the unique identifier for the type Conmon_swapLogPath_Results.
+const Conmon_swapLogPath_Results_TypeID = 0x8aef91973dc8a4f5
+
+func NewConmon_swapLogPath_Results(s *capnp.Segment) (Conmon_swapLogPath_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_swapLogPath_Results{st}, err
+}
+
+func NewRootConmon_swapLogPath_Results(s *capnp.Segment) (Conmon_swapLogPath_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_swapLogPath_Results{st}, err
+}
+
+func ReadRootConmon_swapLogPath_Results(msg *capnp.Message) (Conmon_swapLogPath_Results, error) {
+	root, err := msg.Root()
+	return Conmon_swapLogPath_Results{root.Struct()}, err
+}
+
+func (s Conmon_swapLogPath_Results) String() string {
+	str, _ := text.Marshal(0x8aef91973dc8a4f5, s.Struct)
+	return str
+}
+
+func (s Conmon_swapLogPath_Results) Response() (Conmon_SwapLogPathResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SwapLogPathResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_swapLogPath_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_swapLogPath_Results) SetResponse(v Conmon_SwapLogPathResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SwapLogPathResponse struct, preferring placement in s's segment.
+func (s Conmon_swapLogPath_Results) NewResponse() (Conmon_SwapLogPathResponse, error) {
+	ss, err := NewConmon_SwapLogPathResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SwapLogPathResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_swapLogPath_Results_List is a list of Conmon_swapLogPath_Results.
+type Conmon_swapLogPath_Results_List = capnp.StructList[Conmon_swapLogPath_Results]
+
+// NewConmon_swapLogPath_Results creates a new list of Conmon_swapLogPath_Results.
+func NewConmon_swapLogPath_Results_List(s *capnp.Segment, sz int32) (Conmon_swapLogPath_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_swapLogPath_Results]{List: l}, err
+}
+
+// Conmon_swapLogPath_Results_Future is a wrapper for a Conmon_swapLogPath_Results promised by a client call.
+type Conmon_swapLogPath_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_swapLogPath_Results_Future) Struct() (Conmon_swapLogPath_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_swapLogPath_Results{s}, err
+}
+
+func (p Conmon_swapLogPath_Results_Future) Response() Conmon_SwapLogPathResponse_Future {
+	return Conmon_SwapLogPathResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerNamespaces_Params struct{ capnp.Struct }
+
+// Conmon_containerNamespaces_Params_TypeID is the unique identifier for the type Conmon_containerNamespaces_Params.
+const Conmon_containerNamespaces_Params_TypeID = 0xce733f0914c80b6b
+
+func NewConmon_containerNamespaces_Params(s *capnp.Segment) (Conmon_containerNamespaces_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerNamespaces_Params{st}, err
+}
+
+func NewRootConmon_containerNamespaces_Params(s *capnp.Segment) (Conmon_containerNamespaces_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerNamespaces_Params{st}, err
+}
+
+func ReadRootConmon_containerNamespaces_Params(msg *capnp.Message) (Conmon_containerNamespaces_Params, error) {
+	root, err := msg.Root()
+	return Conmon_containerNamespaces_Params{root.Struct()}, err
+}
+
+func (s Conmon_containerNamespaces_Params) String() string {
+	str, _ := text.Marshal(0xce733f0914c80b6b, s.Struct)
+	return str
+}
+
+func (s Conmon_containerNamespaces_Params) Request() (Conmon_ContainerNamespacesRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerNamespacesRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerNamespaces_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerNamespaces_Params) SetRequest(v Conmon_ContainerNamespacesRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ContainerNamespacesRequest struct, preferring placement in s's segment.
+func (s Conmon_containerNamespaces_Params) NewRequest() (Conmon_ContainerNamespacesRequest, error) {
+	ss, err := NewConmon_ContainerNamespacesRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerNamespacesRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerNamespaces_Params_List is a list of Conmon_containerNamespaces_Params.
+type Conmon_containerNamespaces_Params_List = capnp.StructList[Conmon_containerNamespaces_Params]
+
+// NewConmon_containerNamespaces_Params creates a new list of Conmon_containerNamespaces_Params.
+func NewConmon_containerNamespaces_Params_List(s *capnp.Segment, sz int32) (Conmon_containerNamespaces_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerNamespaces_Params]{List: l}, err
+}
+
+// Conmon_containerNamespaces_Params_Future is a wrapper for a Conmon_containerNamespaces_Params promised by a client call.
+type Conmon_containerNamespaces_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_containerNamespaces_Params_Future) Struct() (Conmon_containerNamespaces_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerNamespaces_Params{s}, err
+}
+
+func (p Conmon_containerNamespaces_Params_Future) Request() Conmon_ContainerNamespacesRequest_Future {
+	return Conmon_ContainerNamespacesRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerNamespaces_Results struct{ capnp.Struct }
+
+// Conmon_containerNamespaces_Results_TypeID is the unique identifier for the type Conmon_containerNamespaces_Results.
+const Conmon_containerNamespaces_Results_TypeID = 0xf4e3e92ae0815f15
+
+func NewConmon_containerNamespaces_Results(s *capnp.Segment) (Conmon_containerNamespaces_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerNamespaces_Results{st}, err
+}
+
+func NewRootConmon_containerNamespaces_Results(s *capnp.Segment) (Conmon_containerNamespaces_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerNamespaces_Results{st}, err
+}
+
+func ReadRootConmon_containerNamespaces_Results(msg *capnp.Message) (Conmon_containerNamespaces_Results, error) {
+	root, err := msg.Root()
+	return Conmon_containerNamespaces_Results{root.Struct()}, err
+}
+
+func (s Conmon_containerNamespaces_Results) String() string {
+	str, _ := text.Marshal(0xf4e3e92ae0815f15, s.Struct)
+	return str
+}
+
+func (s Conmon_containerNamespaces_Results) Response() (Conmon_ContainerNamespacesResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerNamespacesResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerNamespaces_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerNamespaces_Results) SetResponse(v Conmon_ContainerNamespacesResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ContainerNamespacesResponse struct, preferring placement in s's segment.
+func (s Conmon_containerNamespaces_Results) NewResponse() (Conmon_ContainerNamespacesResponse, error) {
+	ss, err := NewConmon_ContainerNamespacesResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerNamespacesResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerNamespaces_Results_List is a list of Conmon_containerNamespaces_Results.
+type Conmon_containerNamespaces_Results_List = capnp.StructList[Conmon_containerNamespaces_Results]
+
+// NewConmon_containerNamespaces_Results creates a new list of Conmon_containerNamespaces_Results.
+func NewConmon_containerNamespaces_Results_List(s *capnp.Segment, sz int32) (Conmon_containerNamespaces_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerNamespaces_Results]{List: l}, err
+}
+
+// Conmon_containerNamespaces_Results_Future is a wrapper for a Conmon_containerNamespaces_Results promised by a client call.
+type Conmon_containerNamespaces_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_containerNamespaces_Results_Future) Struct() (Conmon_containerNamespaces_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerNamespaces_Results{s}, err
+}
+
+func (p Conmon_containerNamespaces_Results_Future) Response() Conmon_ContainerNamespacesResponse_Future {
+	return Conmon_ContainerNamespacesResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_readLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_readLogContainer_Params_TypeID is the unique identifier for the type Conmon_readLogContainer_Params.
+const Conmon_readLogContainer_Params_TypeID = 0x90a3950a51412b8b
+
+func NewConmon_readLogContainer_Params(s *capnp.Segment) (Conmon_readLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Params{st}, err
+}
+
+func NewRootConmon_readLogContainer_Params(s *capnp.Segment) (Conmon_readLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_readLogContainer_Params(msg *capnp.Message) (Conmon_readLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_readLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Params) String() string {
+	str, _ := text.Marshal(0x90a3950a51412b8b, s.Struct)
+	return str
+}
+
+func (s Conmon_readLogContainer_Params) Request() (Conmon_ReadLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReadLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_readLogContainer_Params) SetRequest(v Conmon_ReadLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ReadLogRequest struct, preferring placement in s's segment.
+func (s Conmon_readLogContainer_Params) NewRequest() (Conmon_ReadLogRequest, error) {
+	ss, err := NewConmon_ReadLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReadLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_readLogContainer_Params_List is a list of Conmon_readLogContainer_Params.
+type Conmon_readLogContainer_Params_List = capnp.StructList[Conmon_readLogContainer_Params]
+
+// NewConmon_readLogContainer_Params creates a new list of Conmon_readLogContainer_Params.
+func NewConmon_readLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_readLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_readLogContainer_Params]{List: l}, err
+}
+
+// Conmon_readLogContainer_Params_Future is a wrapper for a Conmon_readLogContainer_Params promised by a client call.
+type Conmon_readLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_readLogContainer_Params_Future) Struct() (Conmon_readLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_readLogContainer_Params{s}, err
+}
+
+func (p Conmon_readLogContainer_Params_Future) Request() Conmon_ReadLogRequest_Future {
+	return Conmon_ReadLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_readLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_readLogContainer_Results_TypeID is the unique identifier for the type Conmon_readLogContainer_Results.
+const Conmon_readLogContainer_Results_TypeID = 0xdebaeed2a782ac80
+
+func NewConmon_readLogContainer_Results(s *capnp.Segment) (Conmon_readLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{st}, err
+}
+
+func NewRootConmon_readLogContainer_Results(s *capnp.Segment) (Conmon_readLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_readLogContainer_Results(msg *capnp.Message) (Conmon_readLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_readLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Results) String() string {
+	str, _ := text.Marshal(0xdebaeed2a782ac80, s.Struct)
+	return str
+}
+
+func (s Conmon_readLogContainer_Results) Response() (Conmon_ReadLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReadLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_readLogContainer_Results) SetResponse(v Conmon_ReadLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ReadLogResponse struct, preferring placement in s's segment.
+func (s Conmon_readLogContainer_Results) NewResponse() (Conmon_ReadLogResponse, error) {
+	ss, err := NewConmon_ReadLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReadLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_readLogContainer_Results_List is a list of Conmon_readLogContainer_Results.
+type Conmon_readLogContainer_Results_List = capnp.StructList[Conmon_readLogContainer_Results]
+
+// NewConmon_readLogContainer_Results creates a new list of Conmon_readLogContainer_Results.
+func NewConmon_readLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_readLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_readLogContainer_Results]{List: l}, err
+}
+
+// Conmon_readLogContainer_Results_Future is a wrapper for a Conmon_readLogContainer_Results promised by a client call.
+type Conmon_readLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_readLogContainer_Results_Future) Struct() (Conmon_readLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_readLogContainer_Results{s}, err
+}
+
+func (p Conmon_readLogContainer_Results_Future) Response() Conmon_ReadLogResponse_Future {
+	return Conmon_ReadLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+<<<<<<< HEAD
+const schema_ffaaf7385bc4adad = "x\xda\xb4Y}t\x14\xd5\x15\xbf\xf7\xcdnf\x97\x8f" +
+	"l\x86\xd9\x00\xe1\xc8IK#j\x10A\x02Us\xf0" +
+	"$\x11S\xc4\x06\xbb\xb3\x81j\xc1c\x1dv\xc7d " +
+	"\xbb\xb3\x99\x995\x04\xeb\xe1C\xfdC(\xd6x\xe4\xd8" +
+	"\xd0z\x0eA\xb1\x92\x12?j\xb1\x82\xa2\xb5\xea\x11l" +
+	"iM\xda\xda\x8a\xc5/J\x05[Q\xfch\x81\x03\x9d" +
+	"\x9e\xf7v\xe7c7\xebq\xb39\xfdk3o~s" +
+	"\xef}\xf7\xdew\xef\xef\xbe\xcc\x9e\x1eh\xf4]:\xfe" +
+	"\x82\x10\x10\xe9\x09\x7f\x99\xb5\x7f\xc2\x8e\xb7N\\\xb1}" +
+	"=\x083\xd0:Yw\xcb\xe1\xdec\x97\xfd\x0a\xfc\x1c" +
+	"\x0fP\xd7\xcd\x7f\x8a\xe2\x16\x9e\x07\x10{\xf8\xc7\x01\xad" +
+	"\xc8\x85\xef<{\xe7\x0b/l\xc8\x05\xfb(\xf6\xa2\xc0" +
+	"\x19\x14\x9b\x03<p\x96\xf1^\xb7\xfe\xc8\x83\x0b\xef\xa0" +
+	"(\x00?\xd2\xd7_\x0fL#\x80\xe2\x15\x81\x06@\xeb" +
+	"\x8b\x87\xf7_\xf9@\xcf\xc7\x1b\xbd\x80\xef\x05\xce \xa0" +
+	"\x98`\x80\xbf]^{\xcb6\xaee\x93\x17\xd0\x13\xf8" +
+	"\x94\x02v0\xc0\xa6\x19M\xd2\x98-\x0f\xdd\xeb\x05\xbc" +
+	"\x12\x98BU\x1cf\x80\xc9\x7f:~\xc3\x1d\x17\x8c\xff" +
+	"I\xde\xb6\x18\xf0\\`\x08\xc5\xaa \xddVe\x90\x82" +
+	"\xef:~\xdd\xd3K\xef\xf8x\x9bW\xda\xbc\xe0\x1c*" +
+	"m1\x03\xf4.?\xb6\xaayQh{\x81}w\x06" +
+	"?Dqs\x90\xee\xbb\xe6\xf1\x97\x067\xce\x9f\xd5\xef" +
+	"\x15\xa3\x06'P1\xeb\x99\x98\xae\x9b\xf7?\xbeF:" +
+	"\xba\xab\x80\x98\xbe\xe0\x10\x8a\xfb\x98\x98\xb9}O=}" +
+	"\xcf\x89\xd5\x8f\x814\x03I\xbe\xed\xbd\xc1~\x14\x9f\x0c" +
+	"N\x02\x10\xf7\x04iHn\x1b\xfc\xf0\xd1{65\xed" +
+	"\xa6\xe8a;\x95\xc6\x10\"&\xc6\xd0\x9d\xaac(\xda" +
+	"y/\xd4p\xd6\xc0\xc0\xcb\xcb/\xffO\xbf\x05\x80u" +
+	"8v\x19\xd6U\x8e\xbd\x0c\x01\xea\xd6\x8f[H\xc4\xa9" +
+	"\xe5<\x80\xf5\xda\xd3;\xeb\xcf\x1c\xe9\xda\x9b/\xbd\x8c" +
+	"J\xf7\x97O \xe2\xf9\xe5,\xbc\xe5\x16\x02Z\x15\xcb" +
+	"\xffp\xe5\xbfn\xfa\xc7+^\x0ftV\xb0\xb0\xdc]" +
+	"A=\xf0\x81\xfc,i>\xd8\xf1\xaa\x170Pq-" +
+	"\x05\x1c\xc8\x00\xfe\xfe\xdf\x95m\xa9Y\xbf\xcb\x00\x98k" +
+	"\x8eW\x0c!\xf8\xac\xa6\x7f\xefz\x95\\\xf7\xd7\x83\x85" +
+	"\"z\xb8\xa2\x9e\x88\xe7*\xe8>O31\xab\xc6\xee" +
+	"\x0f\x07\x1b\x8c\xdf{\xf5\xcc\x14XD\x9b\x05\x0a8U" +
+	"\xf9\xfc\x03S\xe6\xef\xcd\x01(\x02\xb3\xf4v\x06\x98\xd2" +
+	"487\x94\\\xf8z!u}\xc2\xfb(\xee\x13\xa8" +
+	"\xba=\x0c\xfc\xd0g\x8f\xde\xbc\xbb'\xfcF\xc1 \xbc" +
+	")\xf4\xa3xR\xa0!;-t\x01Zg\xef\x9a\xbf" +
+	"n\xea\xd47\xde\xccG\x13\x8a^:\xa1\x96\x88\xe9\x09" +
+	"Tv\xe7\x84\x0f\x00\xad\xad3\xbaR7\xad\xa8\x7f;" +
+	"\x0f\xcd\\\xb3X\x9cB\xc4\x84\xc8\xe2+RC\xd6\xed" +
+	"\xda\xf0\xb3\xa1\x13{\xdf\xf6nk\xb3\xc8\x8e\xde\x0e\x06" +
+	"87.|\xf6\x1b\x7f|\xebm\x10\xe6\x11\xd7l\xc0" +
+	"\xba\x03\xe2\x10\x8aG\x99\xa8\xf7D\x9a*g\xeb\xcf>" +
+	"\xbfm~\xea\x9dB\x85a^\xf85\x14\xa50\x05/" +
+	"\x0eS\xf0\xd2\xd4Baz\xb4\xfc]\xaf\xde\x8f\xc2Q" +
+	"\xaa7XI\xf5\xce\xbem\xe1\xce\x9bT\xf1HN@" +
+	"*\x0f\xd1\x13\xdd\xc4\x00\xaf\x1b\xfc\xb9M\x17_|\xa4" +
+	"\x90\xbf\x95\xca9D\xbc\xab\x92\xaa[\xcf\xc0\xdf\x14_" +
+	"z\"\xd9\xf3\xe1Q\xaf\xb4\xbe\xcaZ\xaan\x0f\x03\xec" +
+	"\x991\xfd\xb1\x0f\xd6<\xf7\xcfB\xd2\xde\xac<\x84\xe2" +
+	"\x17L\xdaI\x06~qy]\xe4/G\xa6\x7f\xc2|" +
+	"\xe2\x9c>\xc0:a\xe2\x10\x8a3'R\xe4E\x13\xab" +
+	"\x01\xad\xc1\x13\xd5\xbb~{\xf4\xdb\x9f\xe5G\xce\xcf*" +
+	"\xe0\xc4C(6St]\xd3\xc4\xeb\xe9qx\xa4\xf3" +
+	"\xa1{OM\x13>\xcf?\xc9\xcc\x87\xbd\x93\xa6\x11q" +
+	"\xcf$\xfa\xe7\xeeI\xd5\x14\xfe\xcc\xd6\xfb\x7f\xf4\xf2\x9c" +
+	"\x85\x9f{wux2\xab\x1f_L\xa6\x86V~\x7f" +
+	"\xfd\xbb\xb5\xc7\x8f\xe4\x00*\xab\xea)`f\x15\x05<" +
+	"\x87\xfdco\\y\xec\x94\x17 U1\xbf\xa8\x0cp" +
+	"\xaa\xef\xe7u\xeb\x0e>u\xba@\x05\xda\\5\x86\x88" +
+	"\x03U<\xcc\xb2bZ2\xa1%g\xeae\xc6\xac\x98" +
+	"\x96Hh\xc9Y)]3\xb5Y\x99\xf5Kbr*" +
+	"\x99\xaa_\x90yh\xed\x92S-Z[D6\xdb\xa3" +
+	"JugZ1\xcc\x08\xa24\x8e\xf3\x01\xf8\x10@h" +
+	"\x9e\x02 5r(\xb5\x10\x14\x10\xc3H\x17\x17\xd5\x02" +
+	"HWs(E\x08\x0a\x84\x84\x91\x00\x08\x8b\xaf\x02\x90" +
+	"\xae\xe1PZB\x90S\xe38\x0e\x08\x8e\x03\x0c\xa5d" +
+	"\xb3\xdd~X\x9bT\xba\"\x9e\xe7\xd2mm0RZ" +
+	"\xd2P\"\xe8\xca\xe0\x8b\x90\xa1\xacVb\xad\xdd\xc9\xd8" +
+	"\x02-i\xcajR\xd1k\"\xb2\xce\xcb\x09C\xf29" +
+	"[\x1eO7\x12\xe0P\x0a\x13\\\xab+\xcc)X\xe1" +
+	"\xa6\x03 V\x8c\xd0t\xc35\xbd&\xda\xa0\x18\xe9\x0e" +
+	"3G\xe3\xb5\x00\xd28\x0e\xa5\xc9\x04-]\xc9l\x0d" +
+	"\x00\xb0\xc2\xed\xd9\xa3\xd4\x1a\xa9\x96\xf5\xa2\xb6\xe90\x8a" +
+	"<\x85\xc5xWW\xe4x\x8b\xd6\x96\xe3\\\x99+F" +
+	"\xab\xd3\xf0K\xd8f4\xa35\xca\x84!\xcb_\x8f\xbe" +
+	")\xae>OV\x8ep[ZJI\xe6l,\xaaT" +
+	"\x8f \x88\x0e\x01)iwY\xe5Q\xc5H\x85J\xc9" +
+	"y\xd94\xe5X{nP\x12XDP\x9c\x1eR\x82" +
+	"\xd9MLi4\xe3\x06\xcc\xb1\xd9_\xc4\xe7-Z\xdb" +
+	"\xd5zH\xbdU\xd1%\x1fzk<\xd6\x86\x96t\xa7" +
+	"\x14o\x85\xaau+T\xe1\x02\x85\xc3\x0bT\xc8\xecN" +
+	")\x18r\x05\x03b(\xbfX%\xe4\xd5\xad\xea\x1a\x05" +
+	"\x83@08\xc2\x9ciU\xcc\xeb\xd5d\\\xeb\xa2\x12" +
+	"2\xa9i\xc2\x97\x97V\xc7\xf09\x85\x0c\xaf/XY" +
+	"\xab\xbb\xd4\xb8\xd9\x8e<\x10\xe4\x01\x1b\xda\x15\xb5\xad\xdd" +
+	"\xb4\x1f\x1dc}_e,\xa7%\xa5\x16\xf4tG\xa1" +
+	"g\x83\xcb\x1c\x85\x9e\xbdnS\x15\xb6D]B#l" +
+	"\xf9\x8d[\x13\x85\xde\xd7\\b$\xf4\x0dy\xf8\xc7N" +
+	"\xdd\xc3\x9cw\xae\xf1P\xb3\x9d\x1b=\xd4|\xe0>\x97" +
+	"\x0c\x0bO\xf6{z\xdd\xee_x\xc6\x9c=[=c" +
+	"\xcc\xbe\xed\x1e\xe2\xf1\xe2!\x97\x11\x09\x07\xa2\x1e\xc6y" +
+	"\xe0}\xcf@1\xb8\xc6C/\x067X\xdfUtC" +
+	"\xd5\x92Q\xce>\xb1\x0btE6\x15\xe7\xb8D\x1b2" +
+	"\xc1\xb3XJ\xaa\xb7*\x80\xbaec\xfc6\xc8\xfe\xb8" +
+	"9\xbf\xbf\xd8\xa1\x07\xcb~E<\xef\xb2\xa7\xc3\xb2O" +
+	"\x0bdZ\xb0\xfb\x9cms\x96]\x05\xb0\xcd\x15\xe8]" +
+	"\xb3\x05\xd9i\x87v\xde\x85\x98\xbc\xfce\xa3:#\xd6" +
+	"n\xa9\xc8zjgZ\xe1(8g\xd1Hi<\x85" +
+	"\xdaV\x13\xfd:9\xa1\x18)9\xa6\x18\xce'\xf6\x12" +
+	"\xe0\x97\x01m1v\xb1\x06\xdb\xad\xf6\x02\xda.\x94j" +
+	"8?\x803J\xa0\xcd=E\x01\xaf\x02\"\xfa\x91G" +
+	"\x97l\xa1=\x09\x08\xa77\x00\x11N\xf2H\x9c\x01\x16" +
+	"m>%\x1c\xbd\x0f\x88\xf0\x1e\x8f\xee\x90\x87\xf6\xac#" +
+	"\xfc\x99~w\x90G\x9fCK\xd1\x1e(\x85\x17\xb7\x02" +
+	"\x11\xf6\xf1\xe8w&\x1f\xb4\xa9\xb2\xf0\xe4^ \xc2\x00" +
+	"\x8fe\xce\xb8\x8b\xf6`,\xf4\xad\x00\"\xf4\xf2\xc8;" +
+	"\x93\x0c\xda\xe4O\xd8\xbc\x1d\x88p7\x8f\x01g\x0aF" +
+	"\x9b\xf6\x0b\xb7o\x04\"t\xf3ko\xcd\xe4d#Z" +
+	"\xb1l\xa2a6e\xa0\x11-\x9b\xc3\xa0\xedi\xd4\x1b" +
+	"\xd1\xb2\xab\xbc\x17\xa9;\x19\x92\x85r\x0a\x85\x1a9\xd9" +
+	"\xb0@K6d>\xa1\xaf\xb2\xb1\x07^6\xdb\x1bY" +
+	"\x15\xc9\xaa\xc8\x06\x93\x8f)\x06\x93\x9c\xe9\xf4h\xe72" +
+	"@#\x8e\xb41\xe5\x9f4O\x03\xafq\xaa\xe4G\xb4" +
+	"J\x1e\xe3P\xfa\xdcC@O.\x03\x90>\xe1P:" +
+	"K\x10\xb3\xfc\xf34\xed\xbf\xa78l\xf5!A\x81#" +
+	"a\xe4\x00D\xc4(@\x149l=\x8f.\xfb\xb80" +
+	"\xfa\x00\xc4*\\\x09\xd0:\x99\xae\xcf\xa5\xeb~_\x18" +
+	"\xfd\x00\xe2\xa5\xb8\x0c\xa0u6]o\xa1\xebe\x18\xc6" +
+	"2\x00q\x11[\xbf\x86\xae/\xa1\xeb\xbc?L\xe9\xb9" +
+	"(\xe1U\x00\xad-t\xfd\x06\xba\x1e\xc00\x06\x00\xc4" +
+	"\xa5\xd8\x0f\xd0z\x03]\x8fc.\xf3X\x91N\xc6;" +
+	"\x94\x88\x0c\x9c\x87\x07\x9b\x8a\x9eP\x93r\x07\xe5\x0b\x08" +
+	"\x04\xe9@\xa1\xacVM\xca\xdd\x00\x0d,\x07\x8cp\xc8" +
+	"\xe0\xe5\x80\x96\xa6%\x9a\xe9[\x08\xc9f\xfb\xb0\xb7\x1d" +
+	"v\xa5\xe2t\xe7]\x85w4b\xa8X\x87f(\xad" +
+	"f\x1c8U\xb3u\xae\xd5\xd3ISM(\x8e]\xd4" +
+	"\x86o\xa9\x1d\x0a^\xaf\xab\xa6\x12U\xcc\x90\xae*\x06" +
+	"\x06\x80`\x00FGC\xa2\x94\x06s\xc5R(\xa7\x85" +
+	"\xe4q\x91@1<\xd8\xdb\x8b\xf3x\x90\x01\xf0\xd5D" +
+	"\xc8iL%\x10\xa1\xecY\xb6Y\xd7\xc8\x0e\x88m\xab" +
+	"\xb7\x92\xba\x93\x8f\xd7\xf0eY\xcf]H\xd0J\xda\xd5" +
+	"\x98S<\xf1\xf7\\\x17d\xe2?\x02Cb\xc3\x0d)" +
+	"~\xa2pZt\x09\x13E,\xb7D\x8c0i\x1c\xae" +
+	"2:\xde\xdd\x99\xe6\xb3sq\xf1sE1$\x979" +
+	"3D\xbd\xc9H\xae{\xb9c\x93\xdc\x80\xa3\xee\"J" +
+	"hk8\x94f\xbb\\q&]\xbb\x90Ci\xae\xcb" +
+	"g\x1d\x19Y>\xdb\xa1&W\x954\xf3\x14 2\xee" +
+	"\xf8!U8\x86\xc9\xd4\xfb7s(u\xb8\x86\xa9\x94" +
+	"\xaf\xc69\x94R\x1e\x12\x9b\xa0\x8b\xed\x1cJ&\xad\xce" +
+	"_c\xd5Y\xe8\xa4_\xa78\x94~@2\xb5f\x81" +
+	"\x16g\xb1\xf3\x01A\x1f`\x83a\xc6\xb5\xb4\x89\xe3\x81" +
+	"\xe0\xf8\xcc\xa3\xa2\xeb\xf6\xa3EKU\xfc;i\xd3[" +
+	"5G\xd5\x80h\xf2p\xc3\xce\xd6JO\x82\xd9'\x01" +
+	"BzD\x8d\x97T\x08\x87M\xc94\xa9\xf9\xa2\x87I" +
+	"\x87\xbd\x96\x90\xd4\xce\xf1\xbddIw\x8aMe\xd2y" +
+	"\xce\x88\x81(4O\x03@\"\\I\x7f8a\x1e\xfd" +
+	"\xf1\x093\xe9\x8f_8\xbf\x16\x00\xcb\x84\xa9\xd3\x00\x1a" +
+	"bm\xba\x96N\xf1j*\xc6'\x92&\x9fTL>" +
+	"\xa5\xc6CiC\xd1\xf9\xb4i\x944)fim1" +
+	"\xd7O\xcb\xdcy\xc8\xb9~\x92t\x00)\xc2\xa1tc" +
+	"\xee\x814\xb4\xd8*\xc5\xcck\xb7\x8cB)\x86\x01\xd5" +
+	"\xaa\x96\\4\xfc\xf4\x8e\xa2\xab\xb0\x0aeb\x91\xc1t" +
+	"\xa6\x9cQt\x96\x91\xd5Dg\xcc+\xa1\x1a\x17\xecG" +
+	"\xee\xad\xe1\xff\xfd\xd6%\"\x87\x8ak9\xce\x8c9\x9a" +
+	"\xeb\xa4\xc2\xc5\xa0\xd6U\x18\x8a\xcb\xa6\xec\x14\xa3\x91\xe8" +
+	"\xb0gI\xdd=\x87>\x96\xc5\xfe!\x00\xa7\xc6\x10=" +
+	"\x9a\xa1c\x8b\x92\xa6\xa2\xdf\"\xc7P\x19\x91\x16{\xb4" +
+	"\xf5\xeed\xb2\xb3\x93^\xea\xba\xfb9\x94\xb6y\x8e\xd6" +
+	"\x83\xd3\x00\xa4\x1fs(=\xec9Z}\xb4t\xff\x94" +
+	"C\xe9QZ\xba\xb9L\xe9\xde\x11\x05\x90\x1e\xe6Pz" +
+	"\x82\xd2j\x1f\xa3\xd5\xc2\xc0\x0a\x00i\x17\x87\xd23\x04" +
+	"\xd1\xcf(\xb5\xb0\x9b\x02\x7f\xc9\xa1\xf4k\x82\xf6dc" +
+	"'\x04o\xcam\xf6\xdf\x0dt?\xaa\xe9\xe1\xc9jG" +
+	"\xfcj\xd9\x04t\xe9\xa8\x9e6L\xba+\xe0=B\xac" +
+	"\x94\xae\xc5\x14\xc3X\x04XZA.8\xb4\xdb-?" +
+	"\xec8\xecv\x9a\xd4\xab9\x94\xeet[\xddzZ\x8a" +
+	"\xd6q(\xfd\xd0\xd3\xea\xee\xa6\x9e\xbd3\xebY\xae1" +
+	"\xe3\xaf\x07\xaf\xf58\xd1G2\xfe\xdaA\x91\xdb8\x94" +
+	"v\xe5\x9e\x13\x1at-m\xb6\x02\xa7\xc4\xec\xeb\xa7\xb5" +
+	"t\x1fr2\x9e\xcf\xf6\x0b\x8c\x0e\xc3h\xfch(W" +
+	"\xd1\xd7\x85\xce\x9dQ)<\xaf\x00\xc1\x1c\xd9E\xb9s" +
+	"\xddS\x82\xf6\xe1\xff\x15\x88*F\xa8x\xdd\xce\xcdW" +
+	"\x09\xba\xf3.\x0am\xb1\x11\xc4\xff\x05\x00\x00\xff\xffZ" +
+	"5\x97\xf3"
+=======
+type Conmon_subscribeEvents_Params struct{ capnp.Struct }
+
+// Conmon_subscribeEvents_Params_TypeID is the unique identifier for the type Conmon_subscribeEvents_Params.
+const Conmon_subscribeEvents_Params_TypeID = 0xa3cb406c522dcab1
+
+func NewConmon_subscribeEvents_Params(s *capnp.Segment) (Conmon_subscribeEvents_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_subscribeEvents_Params{st}, err
+}
+
+func NewRootConmon_subscribeEvents_Params(s *capnp.Segment) (Conmon_subscribeEvents_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_subscribeEvents_Params{st}, err
+}
+
+func ReadRootConmon_subscribeEvents_Params(msg *capnp.Message) (Conmon_subscribeEvents_Params, error) {
+	root, err := msg.Root()
+	return Conmon_subscribeEvents_Params{root.Struct()}, err
+}
+
+func (s Conmon_subscribeEvents_Params) String() string {
+	str, _ := text.Marshal(0xa3cb406c522dcab1, s.Struct)
+	return str
+}
+
+func (s Conmon_subscribeEvents_Params) Request() (Conmon_SubscribeEventsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SubscribeEventsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_subscribeEvents_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_subscribeEvents_Params) SetRequest(v Conmon_SubscribeEventsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SubscribeEventsRequest struct, preferring placement in s's segment.
+func (s Conmon_subscribeEvents_Params) NewRequest() (Conmon_SubscribeEventsRequest, error) {
+	ss, err := NewConmon_SubscribeEventsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SubscribeEventsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_subscribeEvents_Params) Callback() Conmon_EventsCallback {
+	p, _ := s.Struct.Ptr(1)
+	return Conmon_EventsCallback{Client: p.Interface().Client()}
+}
+
+func (s Conmon_subscribeEvents_Params) HasCallback() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_subscribeEvents_Params) SetCallback(v Conmon_EventsCallback) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+// Conmon_subscribeEvents_Params_List is a list of Conmon_subscribeEvents_Params.
+type Conmon_subscribeEvents_Params_List = capnp.StructList[Conmon_subscribeEvents_Params]
+
+// NewConmon_subscribeEvents_Params creates a new list of Conmon_subscribeEvents_Params.
+func NewConmon_subscribeEvents_Params_List(s *capnp.Segment, sz int32) (Conmon_subscribeEvents_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_subscribeEvents_Params]{List: l}, err
+}
+
+// Conmon_subscribeEvents_Params_Future is a wrapper for a Conmon_subscribeEvents_Params promised by a client call.
+type Conmon_subscribeEvents_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_subscribeEvents_Params_Future) Struct() (Conmon_subscribeEvents_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_subscribeEvents_Params{s}, err
+}
+
+func (p Conmon_subscribeEvents_Params_Future) Request() Conmon_SubscribeEventsRequest_Future {
+	return Conmon_SubscribeEventsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_subscribeEvents_Params_Future) Callback() Conmon_EventsCallback {
+	return Conmon_EventsCallback{Client: p.Future.Field(1, nil).Client()}
+}
+
+type Conmon_subscribeEvents_Results struct{ capnp.Struct }
+
+// Conmon_subscribeEvents_Results_TypeID is the unique identifier for the type Conmon_subscribeEvents_Results.
+const Conmon_subscribeEvents_Results_TypeID = 0xedd2e5b018f17bbb
+
+func NewConmon_subscribeEvents_Results(s *capnp.Segment) (Conmon_subscribeEvents_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_subscribeEvents_Results{st}, err
+}
+
+func NewRootConmon_subscribeEvents_Results(s *capnp.Segment) (Conmon_subscribeEvents_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_subscribeEvents_Results{st}, err
+}
+
+func ReadRootConmon_subscribeEvents_Results(msg *capnp.Message) (Conmon_subscribeEvents_Results, error) {
+	root, err := msg.Root()
+	return Conmon_subscribeEvents_Results{root.Struct()}, err
+}
+
+func (s Conmon_subscribeEvents_Results) String() string {
+	str, _ := text.Marshal(0xedd2e5b018f17bbb, s.Struct)
+	return str
+}
+
+func (s Conmon_subscribeEvents_Results) Response() (Conmon_SubscribeEventsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SubscribeEventsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_subscribeEvents_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_subscribeEvents_Results) SetResponse(v Conmon_SubscribeEventsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SubscribeEventsResponse struct, preferring placement in s's segment.
+func (s Conmon_subscribeEvents_Results) NewResponse() (Conmon_SubscribeEventsResponse, error) {
+	ss, err := NewConmon_SubscribeEventsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SubscribeEventsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_subscribeEvents_Results_List is a list of Conmon_subscribeEvents_Results.
+type Conmon_subscribeEvents_Results_List = capnp.StructList[Conmon_subscribeEvents_Results]
+
+// NewConmon_subscribeEvents_Results creates a new list of Conmon_subscribeEvents_Results.
+func NewConmon_subscribeEvents_Results_List(s *capnp.Segment, sz int32) (Conmon_subscribeEvents_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_subscribeEvents_Results]{List: l}, err
+}
+
+// Conmon_subscribeEvents_Results_Future is a wrapper for a Conmon_subscribeEvents_Results promised by a client call.
+type Conmon_subscribeEvents_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_subscribeEvents_Results_Future) Struct() (Conmon_subscribeEvents_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_subscribeEvents_Results{s}, err
+}
+
+func (p Conmon_subscribeEvents_Results_Future) Response() Conmon_SubscribeEventsResponse_Future {
+	return Conmon_SubscribeEventsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerStatus_Params struct{ capnp.Struct }
+
+// Conmon_containerStatus_Params_TypeID is the unique identifier for the type Conmon_containerStatus_Params.
+const Conmon_containerStatus_Params_TypeID = 0x9d82529754851252
+
+func NewConmon_containerStatus_Params(s *capnp.Segment) (Conmon_containerStatus_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStatus_Params{st}, err
+}
+
+func NewRootConmon_containerStatus_Params(s *capnp.Segment) (Conmon_containerStatus_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStatus_Params{st}, err
+}
+
+func ReadRootConmon_containerStatus_Params(msg *capnp.Message) (Conmon_containerStatus_Params, error) {
+	root, err := msg.Root()
+	return Conmon_containerStatus_Params{root.Struct()}, err
+}
+
+func (s Conmon_containerStatus_Params) String() string {
+	str, _ := text.Marshal(0x9d82529754851252, s.Struct)
+	return str
+}
+
+func (s Conmon_containerStatus_Params) Request() (Conmon_ContainerStatusRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerStatusRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerStatus_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerStatus_Params) SetRequest(v Conmon_ContainerStatusRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ContainerStatusRequest struct, preferring placement in s's segment.
+func (s Conmon_containerStatus_Params) NewRequest() (Conmon_ContainerStatusRequest, error) {
+	ss, err := NewConmon_ContainerStatusRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStatusRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerStatus_Params_List is a list of Conmon_containerStatus_Params.
+type Conmon_containerStatus_Params_List = capnp.StructList[Conmon_containerStatus_Params]
+
+// NewConmon_containerStatus_Params creates a new list of Conmon_containerStatus_Params.
+func NewConmon_containerStatus_Params_List(s *capnp.Segment, sz int32) (Conmon_containerStatus_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerStatus_Params]{List: l}, err
+}
+
+// Conmon_containerStatus_Params_Future is a wrapper for a Conmon_containerStatus_Params promised by a client call.
+type Conmon_containerStatus_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_containerStatus_Params_Future) Struct() (Conmon_containerStatus_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerStatus_Params{s}, err
+}
+
+func (p Conmon_containerStatus_Params_Future) Request() Conmon_ContainerStatusRequest_Future {
+	return Conmon_ContainerStatusRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerStatus_Results struct{ capnp.Struct }
+
+// Conmon_containerStatus_Results_TypeID is the unique identifier for the type Conmon_containerStatus_Results.
+const Conmon_containerStatus_Results_TypeID = 0xae5e0ae5001ebdfe
+
+func NewConmon_containerStatus_Results(s *capnp.Segment) (Conmon_containerStatus_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStatus_Results{st}, err
+}
+
+func NewRootConmon_containerStatus_Results(s *capnp.Segment) (Conmon_containerStatus_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStatus_Results{st}, err
+}
+
+func ReadRootConmon_containerStatus_Results(msg *capnp.Message) (Conmon_containerStatus_Results, error) {
+	root, err := msg.Root()
+	return Conmon_containerStatus_Results{root.Struct()}, err
+}
+
+func (s Conmon_containerStatus_Results) String() string {
+	str, _ := text.Marshal(0xae5e0ae5001ebdfe, s.Struct)
+	return str
+}
+
+func (s Conmon_containerStatus_Results) Response() (Conmon_ContainerStatusResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerStatusResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerStatus_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerStatus_Results) SetResponse(v Conmon_ContainerStatusResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ContainerStatusResponse struct, preferring placement in s's segment.
+func (s Conmon_containerStatus_Results) NewResponse() (Conmon_ContainerStatusResponse, error) {
+	ss, err := NewConmon_ContainerStatusResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStatusResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerStatus_Results_List is a list of Conmon_containerStatus_Results.
+type Conmon_containerStatus_Results_List = capnp.StructList[Conmon_containerStatus_Results]
+
+// NewConmon_containerStatus_Results creates a new list of Conmon_containerStatus_Results.
+func NewConmon_containerStatus_Results_List(s *capnp.Segment, sz int32) (Conmon_containerStatus_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerStatus_Results]{List: l}, err
+}
+
+// Conmon_containerStatus_Results_Future is a wrapper for a Conmon_containerStatus_Results promised by a client call.
+type Conmon_containerStatus_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_containerStatus_Results_Future) Struct() (Conmon_containerStatus_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerStatus_Results{s}, err
+}
+
+func (p Conmon_containerStatus_Results_Future) Response() Conmon_ContainerStatusResponse_Future {
+	return Conmon_ContainerStatusResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_restoreContainer_Params struct{ capnp.Struct }
+
+// Conmon_restoreContainer_Params_TypeID is the unique identifier for the type Conmon_restoreContainer_Params.
+const Conmon_restoreContainer_Params_TypeID = 0xa6d76ce69f13a816
+
+func NewConmon_restoreContainer_Params(s *capnp.Segment) (Conmon_restoreContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_restoreContainer_Params{st}, err
+}
+
+func NewRootConmon_restoreContainer_Params(s *capnp.Segment) (Conmon_restoreContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_restoreContainer_Params{st}, err
+}
+
+func ReadRootConmon_restoreContainer_Params(msg *capnp.Message) (Conmon_restoreContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_restoreContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Params) String() string {
+	str, _ := text.Marshal(0xa6d76ce69f13a816, s.Struct)
+	return str
+}
+
+func (s Conmon_restoreContainer_Params) Request() (Conmon_CreateContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_restoreContainer_Params) SetRequest(v Conmon_CreateContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_restoreContainer_Params) NewRequest() (Conmon_CreateContainerRequest, error) {
+	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_restoreContainer_Params) CheckpointPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_restoreContainer_Params) HasCheckpointPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_restoreContainer_Params) CheckpointPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_restoreContainer_Params) SetCheckpointPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_restoreContainer_Params_List is a list of Conmon_restoreContainer_Params.
+type Conmon_restoreContainer_Params_List = capnp.StructList[Conmon_restoreContainer_Params]
+
+// NewConmon_restoreContainer_Params creates a new list of Conmon_restoreContainer_Params.
+func NewConmon_restoreContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_restoreContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_restoreContainer_Params]{List: l}, err
+}
+
+// Conmon_restoreContainer_Params_Future is a wrapper for a Conmon_restoreContainer_Params promised by a client call.
+type Conmon_restoreContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_restoreContainer_Params_Future) Struct() (Conmon_restoreContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_restoreContainer_Params{s}, err
+}
+
+func (p Conmon_restoreContainer_Params_Future) Request() Conmon_CreateContainerRequest_Future {
+	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_restoreContainer_Results struct{ capnp.Struct }
+
+// Conmon_restoreContainer_Results_TypeID is the unique identifier for the type Conmon_restoreContainer_Results.
+const Conmon_restoreContainer_Results_TypeID = 0xaaa69aebe451afba
+
+func NewConmon_restoreContainer_Results(s *capnp.Segment) (Conmon_restoreContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Results{st}, err
+}
+
+func NewRootConmon_restoreContainer_Results(s *capnp.Segment) (Conmon_restoreContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Results{st}, err
+}
+
+func ReadRootConmon_restoreContainer_Results(msg *capnp.Message) (Conmon_restoreContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_restoreContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Results) String() string {
+	str, _ := text.Marshal(0xaaa69aebe451afba, s.Struct)
+	return str
+}
+
+func (s Conmon_restoreContainer_Results) Response() (Conmon_CreateContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_restoreContainer_Results) SetResponse(v Conmon_CreateContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_CreateContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_restoreContainer_Results) NewResponse() (Conmon_CreateContainerResponse, error) {
+	ss, err := NewConmon_CreateContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_restoreContainer_Results_List is a list of Conmon_restoreContainer_Results.
+type Conmon_restoreContainer_Results_List = capnp.StructList[Conmon_restoreContainer_Results]
+
+// NewConmon_restoreContainer_Results creates a new list of Conmon_restoreContainer_Results.
+func NewConmon_restoreContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_restoreContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_restoreContainer_Results]{List: l}, err
+}
+
+// Conmon_restoreContainer_Results_Future is a wrapper for a Conmon_restoreContainer_Results promised by a client call.
+type Conmon_restoreContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_restoreContainer_Results_Future) Struct() (Conmon_restoreContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_restoreContainer_Results{s}, err
+}
+
+func (p Conmon_restoreContainer_Results_Future) Response() Conmon_CreateContainerResponse_Future {
+	return Conmon_CreateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerIoStats_Params struct{ capnp.Struct }
+
+// Conmon_containerIoStats_Params_TypeID is the unique identifier for the type Conmon_containerIoStats_Params.
+const Conmon_containerIoStats_Params_TypeID = 0xe989fde14d6e82dd
+
+func NewConmon_containerIoStats_Params(s *capnp.Segment) (Conmon_containerIoStats_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerIoStats_Params{st}, err
+}
+
+func NewRootConmon_containerIoStats_Params(s *capnp.Segment) (Conmon_containerIoStats_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerIoStats_Params{st}, err
+}
+
+func ReadRootConmon_containerIoStats_Params(msg *capnp.Message) (Conmon_containerIoStats_Params, error) {
+	root, err := msg.Root()
+	return Conmon_containerIoStats_Params{root.Struct()}, err
+}
+
+func (s Conmon_containerIoStats_Params) String() string {
+	str, _ := text.Marshal(0xe989fde14d6e82dd, s.Struct)
+	return str
+}
+
+func (s Conmon_containerIoStats_Params) Request() (Conmon_ContainerIoStatsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerIoStatsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerIoStats_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerIoStats_Params) SetRequest(v Conmon_ContainerIoStatsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ContainerIoStatsRequest struct, preferring placement in s's segment.
+func (s Conmon_containerIoStats_Params) NewRequest() (Conmon_ContainerIoStatsRequest, error) {
+	ss, err := NewConmon_ContainerIoStatsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerIoStatsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerIoStats_Params_List is a list of Conmon_containerIoStats_Params.
+type Conmon_containerIoStats_Params_List = capnp.StructList[Conmon_containerIoStats_Params]
+
+// NewConmon_containerIoStats_Params creates a new list of Conmon_containerIoStats_Params.
+func NewConmon_containerIoStats_Params_List(s *capnp.Segment, sz int32) (Conmon_containerIoStats_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerIoStats_Params]{List: l}, err
+}
+
+// Conmon_containerIoStats_Params_Future is a wrapper for a Conmon_containerIoStats_Params promised by a client call.
+type Conmon_containerIoStats_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_containerIoStats_Params_Future) Struct() (Conmon_containerIoStats_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerIoStats_Params{s}, err
+}
+
+func (p Conmon_containerIoStats_Params_Future) Request() Conmon_ContainerIoStatsRequest_Future {
+	return Conmon_ContainerIoStatsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerIoStats_Results struct{ capnp.Struct }
+
+// Conmon_containerIoStats_Results_TypeID is the unique identifier for the type Conmon_containerIoStats_Results.
+const Conmon_containerIoStats_Results_TypeID = 0x9488d71c49c86c29
+
+func NewConmon_containerIoStats_Results(s *capnp.Segment) (Conmon_containerIoStats_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerIoStats_Results{st}, err
+}
+
+func NewRootConmon_containerIoStats_Results(s *capnp.Segment) (Conmon_containerIoStats_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerIoStats_Results{st}, err
+}
+
+func ReadRootConmon_containerIoStats_Results(msg *capnp.Message) (Conmon_containerIoStats_Results, error) {
+	root, err := msg.Root()
+	return Conmon_containerIoStats_Results{root.Struct()}, err
+}
+
+func (s Conmon_containerIoStats_Results) String() string {
+	str, _ := text.Marshal(0x9488d71c49c86c29, s.Struct)
+	return str
+}
+
+func (s Conmon_containerIoStats_Results) Response() (Conmon_ContainerIoStatsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerIoStatsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerIoStats_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerIoStats_Results) SetResponse(v Conmon_ContainerIoStatsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ContainerIoStatsResponse struct, preferring placement in s's segment.
+func (s Conmon_containerIoStats_Results) NewResponse() (Conmon_ContainerIoStatsResponse, error) {
+	ss, err := NewConmon_ContainerIoStatsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerIoStatsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerIoStats_Results_List is a list of Conmon_containerIoStats_Results.
+type Conmon_containerIoStats_Results_List = capnp.StructList[Conmon_containerIoStats_Results]
+
+// NewConmon_containerIoStats_Results creates a new list of Conmon_containerIoStats_Results.
+func NewConmon_containerIoStats_Results_List(s *capnp.Segment, sz int32) (Conmon_containerIoStats_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerIoStats_Results]{List: l}, err
+}
+
+// Conmon_containerIoStats_Results_Future is a wrapper for a Conmon_containerIoStats_Results promised by a client call.
+type Conmon_containerIoStats_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_containerIoStats_Results_Future) Struct() (Conmon_containerIoStats_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerIoStats_Results{s}, err
+}
+
+func (p Conmon_containerIoStats_Results_Future) Response() Conmon_ContainerIoStatsResponse_Future {
+	return Conmon_ContainerIoStatsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setCpuSetContainer_Params struct{ capnp.Struct }
+
+// Conmon_setCpuSetContainer_Params_TypeID is the unique identifier for the type Conmon_setCpuSetContainer_Params.
+const Conmon_setCpuSetContainer_Params_TypeID = 0xad5e6e3b177fdffd
+
+func NewConmon_setCpuSetContainer_Params(s *capnp.Segment) (Conmon_setCpuSetContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setCpuSetContainer_Params{st}, err
+}
+
+func NewRootConmon_setCpuSetContainer_Params(s *capnp.Segment) (Conmon_setCpuSetContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setCpuSetContainer_Params{st}, err
+}
+
+func ReadRootConmon_setCpuSetContainer_Params(msg *capnp.Message) (Conmon_setCpuSetContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_setCpuSetContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_setCpuSetContainer_Params) String() string {
+	str, _ := text.Marshal(0xad5e6e3b177fdffd, s.Struct)
+	return str
+}
+
+func (s Conmon_setCpuSetContainer_Params) Request() (Conmon_SetCpuSetRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetCpuSetRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setCpuSetContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setCpuSetContainer_Params) SetRequest(v Conmon_SetCpuSetRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SetCpuSetRequest struct, preferring placement in s's segment.
+func (s Conmon_setCpuSetContainer_Params) NewRequest() (Conmon_SetCpuSetRequest, error) {
+	ss, err := NewConmon_SetCpuSetRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetCpuSetRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setCpuSetContainer_Params_List is a list of Conmon_setCpuSetContainer_Params.
+type Conmon_setCpuSetContainer_Params_List = capnp.StructList[Conmon_setCpuSetContainer_Params]
+
+// NewConmon_setCpuSetContainer_Params creates a new list of Conmon_setCpuSetContainer_Params.
+func NewConmon_setCpuSetContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_setCpuSetContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setCpuSetContainer_Params]{List: l}, err
+}
+
+// Conmon_setCpuSetContainer_Params_Future is a wrapper for a Conmon_setCpuSetContainer_Params promised by a client call.
+type Conmon_setCpuSetContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_setCpuSetContainer_Params_Future) Struct() (Conmon_setCpuSetContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setCpuSetContainer_Params{s}, err
+}
+
+func (p Conmon_setCpuSetContainer_Params_Future) Request() Conmon_SetCpuSetRequest_Future {
+	return Conmon_SetCpuSetRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setCpuSetContainer_Results struct{ capnp.Struct }
+
+// Conmon_setCpuSetContainer_Results_TypeID is the unique identifier for the type Conmon_setCpuSetContainer_Results.
+const Conmon_setCpuSetContainer_Results_TypeID = 0xc9701dd28ecc4dec
+
+func NewConmon_setCpuSetContainer_Results(s *capnp.Segment) (Conmon_setCpuSetContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setCpuSetContainer_Results{st}, err
+}
+
+func NewRootConmon_setCpuSetContainer_Results(s *capnp.Segment) (Conmon_setCpuSetContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setCpuSetContainer_Results{st}, err
+}
+
+func ReadRootConmon_setCpuSetContainer_Results(msg *capnp.Message) (Conmon_setCpuSetContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_setCpuSetContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_setCpuSetContainer_Results) String() string {
+	str, _ := text.Marshal(0xc9701dd28ecc4dec, s.Struct)
+	return str
+}
+
+func (s Conmon_setCpuSetContainer_Results) Response() (Conmon_SetCpuSetResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetCpuSetResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setCpuSetContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setCpuSetContainer_Results) SetResponse(v Conmon_SetCpuSetResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SetCpuSetResponse struct, preferring placement in s's segment.
+func (s Conmon_setCpuSetContainer_Results) NewResponse() (Conmon_SetCpuSetResponse, error) {
+	ss, err := NewConmon_SetCpuSetResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetCpuSetResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setCpuSetContainer_Results_List is a list of Conmon_setCpuSetContainer_Results.
+type Conmon_setCpuSetContainer_Results_List = capnp.StructList[Conmon_setCpuSetContainer_Results]
+
+// NewConmon_setCpuSetContainer_Results creates a new list of Conmon_setCpuSetContainer_Results.
+func NewConmon_setCpuSetContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_setCpuSetContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setCpuSetContainer_Results]{List: l}, err
+}
+
+// Conmon_setCpuSetContainer_Results_Future is a wrapper for a Conmon_setCpuSetContainer_Results promised by a client call.
+type Conmon_setCpuSetContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_setCpuSetContainer_Results_Future) Struct() (Conmon_setCpuSetContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setCpuSetContainer_Results{s}, err
+}
+
+func (p Conmon_setCpuSetContainer_Results_Future) Response() Conmon_SetCpuSetResponse_Future {
+	return Conmon_SetCpuSetResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_runRuntimeCommand_Params struct{ capnp.Struct }
+
+// Conmon_runRuntimeCommand_Params_TypeID is the unique identifier for the type Conmon_runRuntimeCommand_Params.
+const Conmon_runRuntimeCommand_Params_TypeID = 0xe1d66f75234ae38a
+
+func NewConmon_runRuntimeCommand_Params(s *capnp.Segment) (Conmon_runRuntimeCommand_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_runRuntimeCommand_Params{st}, err
+}
+
+func NewRootConmon_runRuntimeCommand_Params(s *capnp.Segment) (Conmon_runRuntimeCommand_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_runRuntimeCommand_Params{st}, err
+}
+
+func ReadRootConmon_runRuntimeCommand_Params(msg *capnp.Message) (Conmon_runRuntimeCommand_Params, error) {
+	root, err := msg.Root()
+	return Conmon_runRuntimeCommand_Params{root.Struct()}, err
+}
+
+func (s Conmon_runRuntimeCommand_Params) String() string {
+	str, _ := text.Marshal(0xe1d66f75234ae38a, s.Struct)
+	return str
+}
+
+func (s Conmon_runRuntimeCommand_Params) Request() (Conmon_RunRuntimeCommandRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_RunRuntimeCommandRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_runRuntimeCommand_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_runRuntimeCommand_Params) SetRequest(v Conmon_RunRuntimeCommandRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_RunRuntimeCommandRequest struct, preferring placement in s's segment.
+func (s Conmon_runRuntimeCommand_Params) NewRequest() (Conmon_RunRuntimeCommandRequest, error) {
+	ss, err := NewConmon_RunRuntimeCommandRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_CreateContainerRequest{}, err
+		return Conmon_RunRuntimeCommandRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_createContainer_Params_List is a list of Conmon_createContainer_Params.
-type Conmon_createContainer_Params_List = capnp.StructList[Conmon_createContainer_Params]
+// Conmon_runRuntimeCommand_Params_List is a list of Conmon_runRuntimeCommand_Params.
+type Conmon_runRuntimeCommand_Params_List = capnp.StructList[Conmon_runRuntimeCommand_Params]
 
-// NewConmon_createContainer_Params creates a new list of Conmon_createContainer_Params.
-func NewConmon_createContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Params_List, error) {
+// NewConmon_runRuntimeCommand_Params creates a new list of Conmon_runRuntimeCommand_Params.
+func NewConmon_runRuntimeCommand_Params_List(s *capnp.Segment, sz int32) (Conmon_runRuntimeCommand_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_createContainer_Params]{List: l}, err
+	return capnp.StructList[Conmon_runRuntimeCommand_Params]{List: l}, err
 }
 
-// Conmon_createContainer_Params_Future is a wrapper for a Conmon_createContainer_Params promised by a client call.
-type Conmon_createContainer_Params_Future struct{ *capnp.Future }
+// Conmon_runRuntimeCommand_Params_Future is a wrapper for a Conmon_runRuntimeCommand_Params promised by a client call.
+type Conmon_runRuntimeCommand_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_createContainer_Params_Future) Struct() (Conmon_createContainer_Params, error) {
+func (p Conmon_runRuntimeCommand_Params_Future) Struct() (Conmon_runRuntimeCommand_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_createContainer_Params{s}, err
+	return Conmon_runRuntimeCommand_Params{s}, err
 }
 
-func (p Conmon_createContainer_Params_Future) Request() Conmon_CreateContainerRequest_Future {
-	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_runRuntimeCommand_Params_Future) Request() Conmon_RunRuntimeCommandRequest_Future {
+	return Conmon_RunRuntimeCommandRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_createContainer_Results struct{ capnp.Struct }
+type Conmon_runRuntimeCommand_Results struct{ capnp.Struct }
 
-// Conmon_createContainer_Results_TypeID is the unique identifier for the type Conmon_createContainer_Results.
-const Conmon_createContainer_Results_TypeID = 0xceba3c1a97be15f8
+// Conmon_runRuntimeCommand_Results_TypeID is the unique identifier for the type Conmon_runRuntimeCommand_Results.
+const Conmon_runRuntimeCommand_Results_TypeID = 0xb34e262fa935335a
 
-func NewConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+func NewConmon_runRuntimeCommand_Results(s *capnp.Segment) (Conmon_runRuntimeCommand_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Results{st}, err
+	return Conmon_runRuntimeCommand_Results{st}, err
 }
 
-func NewRootConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+func NewRootConmon_runRuntimeCommand_Results(s *capnp.Segment) (Conmon_runRuntimeCommand_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Results{st}, err
+	return Conmon_runRuntimeCommand_Results{st}, err
 }
 
-func ReadRootConmon_createContainer_Results(msg *capnp.Message) (Conmon_createContainer_Results, error) {
+func ReadRootConmon_runRuntimeCommand_Results(msg *capnp.Message) (Conmon_runRuntimeCommand_Results, error) {
 	root, err := msg.Root()
-	return Conmon_createContainer_Results{root.Struct()}, err
+	return Conmon_runRuntimeCommand_Results{root.Struct()}, err
 }
 
-func (s Conmon_createContainer_Results) String() string {
-	str, _ := text.Marshal(0xceba3c1a97be15f8, s.Struct)
+func (s Conmon_runRuntimeCommand_Results) String() string {
+	str, _ := text.Marshal(0xb34e262fa935335a, s.Struct)
 	return str
 }
 
-func (s Conmon_createContainer_Results) Response() (Conmon_CreateContainerResponse, error) {
+func (s Conmon_runRuntimeCommand_Results) Response() (Conmon_RunRuntimeCommandResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_CreateContainerResponse{Struct: p.Struct()}, err
+	return Conmon_RunRuntimeCommandResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_createContainer_Results) HasResponse() bool {
+func (s Conmon_runRuntimeCommand_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_createContainer_Results) SetResponse(v Conmon_CreateContainerResponse) error {
+func (s Conmon_runRuntimeCommand_Results) SetResponse(v Conmon_RunRuntimeCommandResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_CreateContainerResponse struct, preferring placement in s's segment.
-func (s Conmon_createContainer_Results) NewResponse() (Conmon_CreateContainerResponse, error) {
-	ss, err := NewConmon_CreateContainerResponse(s.Struct.Segment())
+// allocated Conmon_RunRuntimeCommandResponse struct, preferring placement in s's segment.
+func (s Conmon_runRuntimeCommand_Results) NewResponse() (Conmon_RunRuntimeCommandResponse, error) {
+	ss, err := NewConmon_RunRuntimeCommandResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_CreateContainerResponse{}, err
+		return Conmon_RunRuntimeCommandResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_createContainer_Results_List is a list of Conmon_createContainer_Results.
-type Conmon_createContainer_Results_List = capnp.StructList[Conmon_createContainer_Results]
+// Conmon_runRuntimeCommand_Results_List is a list of Conmon_runRuntimeCommand_Results.
+type Conmon_runRuntimeCommand_Results_List = capnp.StructList[Conmon_runRuntimeCommand_Results]
 
-// NewConmon_createContainer_Results creates a new list of Conmon_createContainer_Results.
-func NewConmon_createContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Results_List, error) {
+// NewConmon_runRuntimeCommand_Results creates a new list of Conmon_runRuntimeCommand_Results.
+func NewConmon_runRuntimeCommand_Results_List(s *capnp.Segment, sz int32) (Conmon_runRuntimeCommand_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_createContainer_Results]{List: l}, err
+	return capnp.StructList[Conmon_runRuntimeCommand_Results]{List: l}, err
 }
 
-// Conmon_createContainer_Results_Future is a wrapper for a Conmon_createContainer_Results promised by a client call.
-type Conmon_createContainer_Results_Future struct{ *capnp.Future }
+// Conmon_runRuntimeCommand_Results_Future is a wrapper for a Conmon_runRuntimeCommand_Results promised by a client call.
+type Conmon_runRuntimeCommand_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_createContainer_Results_Future) Struct() (Conmon_createContainer_Results, error) {
+func (p Conmon_runRuntimeCommand_Results_Future) Struct() (Conmon_runRuntimeCommand_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_createContainer_Results{s}, err
+	return Conmon_runRuntimeCommand_Results{s}, err
 }
 
-func (p Conmon_createContainer_Results_Future) Response() Conmon_CreateContainerResponse_Future {
-	return Conmon_CreateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_runRuntimeCommand_Results_Future) Response() Conmon_RunRuntimeCommandResponse_Future {
+	return Conmon_RunRuntimeCommandResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_execSyncContainer_Params struct{ capnp.Struct }
+type Conmon_startContainer_Params struct{ capnp.Struct }
 
-// Conmon_execSyncContainer_Params_TypeID is the unique identifier for the type Conmon_execSyncContainer_Params.
-const Conmon_execSyncContainer_Params_TypeID = 0x83479da67279e173
+// Conmon_startContainer_Params_TypeID is the unique identifier for the type Conmon_startContainer_Params.
+const Conmon_startContainer_Params_TypeID = 0xd2cb6549091ed7df
 
-func NewConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+func NewConmon_startContainer_Params(s *capnp.Segment) (Conmon_startContainer_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Params{st}, err
+	return Conmon_startContainer_Params{st}, err
 }
 
-func NewRootConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+func NewRootConmon_startContainer_Params(s *capnp.Segment) (Conmon_startContainer_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Params{st}, err
+	return Conmon_startContainer_Params{st}, err
 }
 
-func ReadRootConmon_execSyncContainer_Params(msg *capnp.Message) (Conmon_execSyncContainer_Params, error) {
+func ReadRootConmon_startContainer_Params(msg *capnp.Message) (Conmon_startContainer_Params, error) {
 	root, err := msg.Root()
-	return Conmon_execSyncContainer_Params{root.Struct()}, err
+	return Conmon_startContainer_Params{root.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Params) String() string {
-	str, _ := text.Marshal(0x83479da67279e173, s.Struct)
+func (s Conmon_startContainer_Params) String() string {
+	str, _ := text.Marshal(0xd2cb6549091ed7df, s.Struct)
 	return str
 }
 
-func (s Conmon_execSyncContainer_Params) Request() (Conmon_ExecSyncContainerRequest, error) {
+func (s Conmon_startContainer_Params) Request() (Conmon_StartContainerRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ExecSyncContainerRequest{Struct: p.Struct()}, err
+	return Conmon_StartContainerRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Params) HasRequest() bool {
+func (s Conmon_startContainer_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_execSyncContainer_Params) SetRequest(v Conmon_ExecSyncContainerRequest) error {
+func (s Conmon_startContainer_Params) SetRequest(v Conmon_StartContainerRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_ExecSyncContainerRequest struct, preferring placement in s's segment.
-func (s Conmon_execSyncContainer_Params) NewRequest() (Conmon_ExecSyncContainerRequest, error) {
-	ss, err := NewConmon_ExecSyncContainerRequest(s.Struct.Segment())
+// allocated Conmon_StartContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_startContainer_Params) NewRequest() (Conmon_StartContainerRequest, error) {
+	ss, err := NewConmon_StartContainerRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ExecSyncContainerRequest{}, err
+		return Conmon_StartContainerRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_execSyncContainer_Params_List is a list of Conmon_execSyncContainer_Params.
-type Conmon_execSyncContainer_Params_List = capnp.StructList[Conmon_execSyncContainer_Params]
+// Conmon_startContainer_Params_List is a list of Conmon_startContainer_Params.
+type Conmon_startContainer_Params_List = capnp.StructList[Conmon_startContainer_Params]
 
-// NewConmon_execSyncContainer_Params creates a new list of Conmon_execSyncContainer_Params.
-func NewConmon_execSyncContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Params_List, error) {
+// NewConmon_startContainer_Params creates a new list of Conmon_startContainer_Params.
+func NewConmon_startContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_startContainer_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_execSyncContainer_Params]{List: l}, err
+	return capnp.StructList[Conmon_startContainer_Params]{List: l}, err
 }
 
-// Conmon_execSyncContainer_Params_Future is a wrapper for a Conmon_execSyncContainer_Params promised by a client call.
-type Conmon_execSyncContainer_Params_Future struct{ *capnp.Future }
+// Conmon_startContainer_Params_Future is a wrapper for a Conmon_startContainer_Params promised by a client call.
+type Conmon_startContainer_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_execSyncContainer_Params_Future) Struct() (Conmon_execSyncContainer_Params, error) {
+func (p Conmon_startContainer_Params_Future) Struct() (Conmon_startContainer_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_execSyncContainer_Params{s}, err
+	return Conmon_startContainer_Params{s}, err
 }
 
-func (p Conmon_execSyncContainer_Params_Future) Request() Conmon_ExecSyncContainerRequest_Future {
-	return Conmon_ExecSyncContainerRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_startContainer_Params_Future) Request() Conmon_StartContainerRequest_Future {
+	return Conmon_StartContainerRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_execSyncContainer_Results struct{ capnp.Struct }
+type Conmon_startContainer_Results struct{ capnp.Struct }
 
-// Conmon_execSyncContainer_Results_TypeID is the unique identifier for the type Conmon_execSyncContainer_Results.
-const Conmon_execSyncContainer_Results_TypeID = 0xf8e86a5c0baa01bc
+// Conmon_startContainer_Results_TypeID is the unique identifier for the type Conmon_startContainer_Results.
+const Conmon_startContainer_Results_TypeID = 0x97c2918f8d3765ca
 
-func NewConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+func NewConmon_startContainer_Results(s *capnp.Segment) (Conmon_startContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_startContainer_Results{st}, err
+}
+
+func NewRootConmon_startContainer_Results(s *capnp.Segment) (Conmon_startContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_startContainer_Results{st}, err
+}
+
+func ReadRootConmon_startContainer_Results(msg *capnp.Message) (Conmon_startContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_startContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_startContainer_Results) String() string {
+	str, _ := text.Marshal(0x97c2918f8d3765ca, s.Struct)
+	return str
+}
+
+func (s Conmon_startContainer_Results) Response() (Conmon_StartContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_StartContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_startContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_startContainer_Results) SetResponse(v Conmon_StartContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_StartContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_startContainer_Results) NewResponse() (Conmon_StartContainerResponse, error) {
+	ss, err := NewConmon_StartContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_StartContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_startContainer_Results_List is a list of Conmon_startContainer_Results.
+type Conmon_startContainer_Results_List = capnp.StructList[Conmon_startContainer_Results]
+
+// NewConmon_startContainer_Results creates a new list of Conmon_startContainer_Results.
+func NewConmon_startContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_startContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_startContainer_Results]{List: l}, err
+}
+
+// Conmon_startContainer_Results_Future is a wrapper for a Conmon_startContainer_Results promised by a client call.
+type Conmon_startContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_startContainer_Results_Future) Struct() (Conmon_startContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_startContainer_Results{s}, err
+}
+
+func (p Conmon_startContainer_Results_Future) Response() Conmon_StartContainerResponse_Future {
+	return Conmon_StartContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_serverLogConfig_Params struct{ capnp.Struct }
+
+// Conmon_serverLogConfig_Params_TypeID is the unique identifier for the type Conmon_serverLogConfig_Params.
+const Conmon_serverLogConfig_Params_TypeID = 0xa01442f335a6cc00
+
+func NewConmon_serverLogConfig_Params(s *capnp.Segment) (Conmon_serverLogConfig_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_serverLogConfig_Params{st}, err
+}
+
+func NewRootConmon_serverLogConfig_Params(s *capnp.Segment) (Conmon_serverLogConfig_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_serverLogConfig_Params{st}, err
+}
+
+func ReadRootConmon_serverLogConfig_Params(msg *capnp.Message) (Conmon_serverLogConfig_Params, error) {
+	root, err := msg.Root()
+	return Conmon_serverLogConfig_Params{root.Struct()}, err
+}
+
+func (s Conmon_serverLogConfig_Params) String() string {
+	str, _ := text.Marshal(0xa01442f335a6cc00, s.Struct)
+	return str
+}
+
+// Conmon_serverLogConfig_Params_List is a list of Conmon_serverLogConfig_Params.
+type Conmon_serverLogConfig_Params_List = capnp.StructList[Conmon_serverLogConfig_Params]
+
+// NewConmon_serverLogConfig_Params creates a new list of Conmon_serverLogConfig_Params.
+func NewConmon_serverLogConfig_Params_List(s *capnp.Segment, sz int32) (Conmon_serverLogConfig_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_serverLogConfig_Params]{List: l}, err
+}
+
+// Conmon_serverLogConfig_Params_Future is a wrapper for a Conmon_serverLogConfig_Params promised by a client call.
+type Conmon_serverLogConfig_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_serverLogConfig_Params_Future) Struct() (Conmon_serverLogConfig_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_serverLogConfig_Params{s}, err
+}
+
+type Conmon_serverLogConfig_Results struct{ capnp.Struct }
+
+// Conmon_serverLogConfig_Results_TypeID is the unique identifier for the type Conmon_serverLogConfig_Results.
+const Conmon_serverLogConfig_Results_TypeID = 0xa85a62dd95c50d7f
+
+func NewConmon_serverLogConfig_Results(s *capnp.Segment) (Conmon_serverLogConfig_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serverLogConfig_Results{st}, err
+}
+
+func NewRootConmon_serverLogConfig_Results(s *capnp.Segment) (Conmon_serverLogConfig_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serverLogConfig_Results{st}, err
+}
+
+func ReadRootConmon_serverLogConfig_Results(msg *capnp.Message) (Conmon_serverLogConfig_Results, error) {
+	root, err := msg.Root()
+	return Conmon_serverLogConfig_Results{root.Struct()}, err
+}
+
+func (s Conmon_serverLogConfig_Results) String() string {
+	str, _ := text.Marshal(0xa85a62dd95c50d7f, s.Struct)
+	return str
+}
+
+func (s Conmon_serverLogConfig_Results) Response() (Conmon_ServerLogConfigResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ServerLogConfigResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_serverLogConfig_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_serverLogConfig_Results) SetResponse(v Conmon_ServerLogConfigResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ServerLogConfigResponse struct, preferring placement in s's segment.
+func (s Conmon_serverLogConfig_Results) NewResponse() (Conmon_ServerLogConfigResponse, error) {
+	ss, err := NewConmon_ServerLogConfigResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ServerLogConfigResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_serverLogConfig_Results_List is a list of Conmon_serverLogConfig_Results.
+type Conmon_serverLogConfig_Results_List = capnp.StructList[Conmon_serverLogConfig_Results]
+
+// NewConmon_serverLogConfig_Results creates a new list of Conmon_serverLogConfig_Results.
+func NewConmon_serverLogConfig_Results_List(s *capnp.Segment, sz int32) (Conmon_serverLogConfig_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_serverLogConfig_Results]{List: l}, err
+}
+
+// Conmon_serverLogConfig_Results_Future is a wrapper for a Conmon_serverLogConfig_Results promised by a client call.
+type Conmon_serverLogConfig_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_serverLogConfig_Results_Future) Struct() (Conmon_serverLogConfig_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_serverLogConfig_Results{s}, err
+}
+
+func (p Conmon_serverLogConfig_Results_Future) Response() Conmon_ServerLogConfigResponse_Future {
+	return Conmon_ServerLogConfigResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_listContainers_Params struct{ capnp.Struct }
+
+// Conmon_listContainers_Params_TypeID is the unique identifier for the type Conmon_listContainers_Params.
+const Conmon_listContainers_Params_TypeID = 0xdfca9ee49ebf0d98
+
+func NewConmon_listContainers_Params(s *capnp.Segment) (Conmon_listContainers_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_listContainers_Params{st}, err
+}
+
+func NewRootConmon_listContainers_Params(s *capnp.Segment) (Conmon_listContainers_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_listContainers_Params{st}, err
+}
+
+func ReadRootConmon_listContainers_Params(msg *capnp.Message) (Conmon_listContainers_Params, error) {
+	root, err := msg.Root()
+	return Conmon_listContainers_Params{root.Struct()}, err
+}
+
+func (s Conmon_listContainers_Params) String() string {
+	str, _ := text.Marshal(0xdfca9ee49ebf0d98, s.Struct)
+	return str
+}
+
+// Conmon_listContainers_Params_List is a list of Conmon_listContainers_Params.
+type Conmon_listContainers_Params_List = capnp.StructList[Conmon_listContainers_Params]
+
+// NewConmon_listContainers_Params creates a new list of Conmon_listContainers_Params.
+func NewConmon_listContainers_Params_List(s *capnp.Segment, sz int32) (Conmon_listContainers_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_listContainers_Params]{List: l}, err
+}
+
+// Conmon_listContainers_Params_Future is a wrapper for a Conmon_listContainers_Params promised by a client call.
+type Conmon_listContainers_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_listContainers_Params_Future) Struct() (Conmon_listContainers_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_listContainers_Params{s}, err
+}
+
+type Conmon_listContainers_Results struct{ capnp.Struct }
+
+// Conmon_listContainers_Results_TypeID is the unique identifier for the type Conmon_listContainers_Results.
+const Conmon_listContainers_Results_TypeID = 0x968709e5ac646fae
+
+func NewConmon_listContainers_Results(s *capnp.Segment) (Conmon_listContainers_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Results{st}, err
+	return Conmon_listContainers_Results{st}, err
 }
 
-func NewRootConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+func NewRootConmon_listContainers_Results(s *capnp.Segment) (Conmon_listContainers_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Results{st}, err
+	return Conmon_listContainers_Results{st}, err
 }
 
-func ReadRootConmon_execSyncContainer_Results(msg *capnp.Message) (Conmon_execSyncContainer_Results, error) {
+func ReadRootConmon_listContainers_Results(msg *capnp.Message) (Conmon_listContainers_Results, error) {
 	root, err := msg.Root()
-	return Conmon_execSyncContainer_Results{root.Struct()}, err
+	return Conmon_listContainers_Results{root.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Results) String() string {
-	str, _ := text.Marshal(0xf8e86a5c0baa01bc, s.Struct)
+func (s Conmon_listContainers_Results) String() string {
+	str, _ := text.Marshal(0x968709e5ac646fae, s.Struct)
 	return str
 }
 
-func (s Conmon_execSyncContainer_Results) Response() (Conmon_ExecSyncContainerResponse, error) {
+func (s Conmon_listContainers_Results) Response() (Conmon_ListContainersResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ExecSyncContainerResponse{Struct: p.Struct()}, err
+	return Conmon_ListContainersResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Results) HasResponse() bool {
+func (s Conmon_listContainers_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_execSyncContainer_Results) SetResponse(v Conmon_ExecSyncContainerResponse) error {
+func (s Conmon_listContainers_Results) SetResponse(v Conmon_ListContainersResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_ExecSyncContainerResponse struct, preferring placement in s's segment.
-func (s Conmon_execSyncContainer_Results) NewResponse() (Conmon_ExecSyncContainerResponse, error) {
-	ss, err := NewConmon_ExecSyncContainerResponse(s.Struct.Segment())
+// allocated Conmon_ListContainersResponse struct, preferring placement in s's segment.
+func (s Conmon_listContainers_Results) NewResponse() (Conmon_ListContainersResponse, error) {
+	ss, err := NewConmon_ListContainersResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ExecSyncContainerResponse{}, err
+		return Conmon_ListContainersResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_execSyncContainer_Results_List is a list of Conmon_execSyncContainer_Results.
-type Conmon_execSyncContainer_Results_List = capnp.StructList[Conmon_execSyncContainer_Results]
+// Conmon_listContainers_Results_List is a list of Conmon_listContainers_Results.
+type Conmon_listContainers_Results_List = capnp.StructList[Conmon_listContainers_Results]
 
-// NewConmon_execSyncContainer_Results creates a new list of Conmon_execSyncContainer_Results.
-func NewConmon_execSyncContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Results_List, error) {
+// NewConmon_listContainers_Results creates a new list of Conmon_listContainers_Results.
+func NewConmon_listContainers_Results_List(s *capnp.Segment, sz int32) (Conmon_listContainers_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_execSyncContainer_Results]{List: l}, err
+	return capnp.StructList[Conmon_listContainers_Results]{List: l}, err
 }
 
-// Conmon_execSyncContainer_Results_Future is a wrapper for a Conmon_execSyncContainer_Results promised by a client call.
-type Conmon_execSyncContainer_Results_Future struct{ *capnp.Future }
+// Conmon_listContainers_Results_Future is a wrapper for a Conmon_listContainers_Results promised by a client call.
+type Conmon_listContainers_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_execSyncContainer_Results_Future) Struct() (Conmon_execSyncContainer_Results, error) {
+func (p Conmon_listContainers_Results_Future) Struct() (Conmon_listContainers_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_execSyncContainer_Results{s}, err
+	return Conmon_listContainers_Results{s}, err
 }
 
-func (p Conmon_execSyncContainer_Results_Future) Response() Conmon_ExecSyncContainerResponse_Future {
-	return Conmon_ExecSyncContainerResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_listContainers_Results_Future) Response() Conmon_ListContainersResponse_Future {
+	return Conmon_ListContainersResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_attachContainer_Params struct{ capnp.Struct }
+type Conmon_attachBuffer_Params struct{ capnp.Struct }
 
-// Conmon_attachContainer_Params_TypeID is the unique identifier for the type Conmon_attachContainer_Params.
-const Conmon_attachContainer_Params_TypeID = 0xaa2f3c8ad1c3af24
+// Conmon_attachBuffer_Params_TypeID is the unique identifier for the type Conmon_attachBuffer_Params.
+const Conmon_attachBuffer_Params_TypeID = 0xf18bd11dcac0404b
 
-func NewConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+func NewConmon_attachBuffer_Params(s *capnp.Segment) (Conmon_attachBuffer_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Params{st}, err
+	return Conmon_attachBuffer_Params{st}, err
 }
 
-func NewRootConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+func NewRootConmon_attachBuffer_Params(s *capnp.Segment) (Conmon_attachBuffer_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Params{st}, err
+	return Conmon_attachBuffer_Params{st}, err
 }
 
-func ReadRootConmon_attachContainer_Params(msg *capnp.Message) (Conmon_attachContainer_Params, error) {
+func ReadRootConmon_attachBuffer_Params(msg *capnp.Message) (Conmon_attachBuffer_Params, error) {
 	root, err := msg.Root()
-	return Conmon_attachContainer_Params{root.Struct()}, err
+	return Conmon_attachBuffer_Params{root.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Params) String() string {
-	str, _ := text.Marshal(0xaa2f3c8ad1c3af24, s.Struct)
+func (s Conmon_attachBuffer_Params) String() string {
+	str, _ := text.Marshal(0xf18bd11dcac0404b, s.Struct)
 	return str
 }
 
-func (s Conmon_attachContainer_Params) Request() (Conmon_AttachRequest, error) {
+func (s Conmon_attachBuffer_Params) Request() (Conmon_AttachBufferRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_AttachRequest{Struct: p.Struct()}, err
+	return Conmon_AttachBufferRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Params) HasRequest() bool {
+func (s Conmon_attachBuffer_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_attachContainer_Params) SetRequest(v Conmon_AttachRequest) error {
+func (s Conmon_attachBuffer_Params) SetRequest(v Conmon_AttachBufferRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_AttachRequest struct, preferring placement in s's segment.
-func (s Conmon_attachContainer_Params) NewRequest() (Conmon_AttachRequest, error) {
-	ss, err := NewConmon_AttachRequest(s.Struct.Segment())
+// allocated Conmon_AttachBufferRequest struct, preferring placement in s's segment.
+func (s Conmon_attachBuffer_Params) NewRequest() (Conmon_AttachBufferRequest, error) {
+	ss, err := NewConmon_AttachBufferRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_AttachRequest{}, err
+		return Conmon_AttachBufferRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_attachContainer_Params_List is a list of Conmon_attachContainer_Params.
-type Conmon_attachContainer_Params_List = capnp.StructList[Conmon_attachContainer_Params]
+// Conmon_attachBuffer_Params_List is a list of Conmon_attachBuffer_Params.
+type Conmon_attachBuffer_Params_List = capnp.StructList[Conmon_attachBuffer_Params]
 
-// NewConmon_attachContainer_Params creates a new list of Conmon_attachContainer_Params.
-func NewConmon_attachContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Params_List, error) {
+// NewConmon_attachBuffer_Params creates a new list of Conmon_attachBuffer_Params.
+func NewConmon_attachBuffer_Params_List(s *capnp.Segment, sz int32) (Conmon_attachBuffer_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_attachContainer_Params]{List: l}, err
+	return capnp.StructList[Conmon_attachBuffer_Params]{List: l}, err
 }
 
-// Conmon_attachContainer_Params_Future is a wrapper for a Conmon_attachContainer_Params promised by a client call.
-type Conmon_attachContainer_Params_Future struct{ *capnp.Future }
+// Conmon_attachBuffer_Params_Future is a wrapper for a Conmon_attachBuffer_Params promised by a client call.
+type Conmon_attachBuffer_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_attachContainer_Params_Future) Struct() (Conmon_attachContainer_Params, error) {
+func (p Conmon_attachBuffer_Params_Future) Struct() (Conmon_attachBuffer_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_attachContainer_Params{s}, err
+	return Conmon_attachBuffer_Params{s}, err
 }
 
-func (p Conmon_attachContainer_Params_Future) Request() Conmon_AttachRequest_Future {
-	return Conmon_AttachRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_attachBuffer_Params_Future) Request() Conmon_AttachBufferRequest_Future {
+	return Conmon_AttachBufferRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_attachContainer_Results struct{ capnp.Struct }
+type Conmon_attachBuffer_Results struct{ capnp.Struct }
 
-// Conmon_attachContainer_Results_TypeID is the unique identifier for the type Conmon_attachContainer_Results.
-const Conmon_attachContainer_Results_TypeID = 0xc5e65eec3dcf5b10
+// Conmon_attachBuffer_Results_TypeID is the unique identifier for the type Conmon_attachBuffer_Results.
+const Conmon_attachBuffer_Results_TypeID = 0xa199c5435b00304a
 
-func NewConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+func NewConmon_attachBuffer_Results(s *capnp.Segment) (Conmon_attachBuffer_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Results{st}, err
+	return Conmon_attachBuffer_Results{st}, err
 }
 
-func NewRootConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+func NewRootConmon_attachBuffer_Results(s *capnp.Segment) (Conmon_attachBuffer_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Results{st}, err
+	return Conmon_attachBuffer_Results{st}, err
 }
 
-func ReadRootConmon_attachContainer_Results(msg *capnp.Message) (Conmon_attachContainer_Results, error) {
+func ReadRootConmon_attachBuffer_Results(msg *capnp.Message) (Conmon_attachBuffer_Results, error) {
 	root, err := msg.Root()
-	return Conmon_attachContainer_Results{root.Struct()}, err
+	return Conmon_attachBuffer_Results{root.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Results) String() string {
-	str, _ := text.Marshal(0xc5e65eec3dcf5b10, s.Struct)
+func (s Conmon_attachBuffer_Results) String() string {
+	str, _ := text.Marshal(0xa199c5435b00304a, s.Struct)
 	return str
 }
 
-func (s Conmon_attachContainer_Results) Response() (Conmon_AttachResponse, error) {
+func (s Conmon_attachBuffer_Results) Response() (Conmon_AttachBufferResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_AttachResponse{Struct: p.Struct()}, err
+	return Conmon_AttachBufferResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Results) HasResponse() bool {
+func (s Conmon_attachBuffer_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_attachContainer_Results) SetResponse(v Conmon_AttachResponse) error {
+func (s Conmon_attachBuffer_Results) SetResponse(v Conmon_AttachBufferResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_AttachResponse struct, preferring placement in s's segment.
-func (s Conmon_attachContainer_Results) NewResponse() (Conmon_AttachResponse, error) {
-	ss, err := NewConmon_AttachResponse(s.Struct.Segment())
+// allocated Conmon_AttachBufferResponse struct, preferring placement in s's segment.
+func (s Conmon_attachBuffer_Results) NewResponse() (Conmon_AttachBufferResponse, error) {
+	ss, err := NewConmon_AttachBufferResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_AttachResponse{}, err
+		return Conmon_AttachBufferResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_attachContainer_Results_List is a list of Conmon_attachContainer_Results.
-type Conmon_attachContainer_Results_List = capnp.StructList[Conmon_attachContainer_Results]
+// Conmon_attachBuffer_Results_List is a list of Conmon_attachBuffer_Results.
+type Conmon_attachBuffer_Results_List = capnp.StructList[Conmon_attachBuffer_Results]
 
-// NewConmon_attachContainer_Results creates a new list of Conmon_attachContainer_Results.
-func NewConmon_attachContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Results_List, error) {
+// NewConmon_attachBuffer_Results creates a new list of Conmon_attachBuffer_Results.
+func NewConmon_attachBuffer_Results_List(s *capnp.Segment, sz int32) (Conmon_attachBuffer_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_attachContainer_Results]{List: l}, err
+	return capnp.StructList[Conmon_attachBuffer_Results]{List: l}, err
 }
 
-// Conmon_attachContainer_Results_Future is a wrapper for a Conmon_attachContainer_Results promised by a client call.
-type Conmon_attachContainer_Results_Future struct{ *capnp.Future }
+// Conmon_attachBuffer_Results_Future is a wrapper for a Conmon_attachBuffer_Results promised by a client call.
+type Conmon_attachBuffer_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_attachContainer_Results_Future) Struct() (Conmon_attachContainer_Results, error) {
+func (p Conmon_attachBuffer_Results_Future) Struct() (Conmon_attachBuffer_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_attachContainer_Results{s}, err
+	return Conmon_attachBuffer_Results{s}, err
 }
 
-func (p Conmon_attachContainer_Results_Future) Response() Conmon_AttachResponse_Future {
-	return Conmon_AttachResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_attachBuffer_Results_Future) Response() Conmon_AttachBufferResponse_Future {
+	return Conmon_AttachBufferResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_reopenLogContainer_Params struct{ capnp.Struct }
+type Conmon_capabilities_Params struct{ capnp.Struct }
 
-// Conmon_reopenLogContainer_Params_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Params.
-const Conmon_reopenLogContainer_Params_TypeID = 0xe5ea916eb0c31336
+// Conmon_capabilities_Params_TypeID is the unique identifier for the type Conmon_capabilities_Params.
+const Conmon_capabilities_Params_TypeID = 0x8ceb3503d8b127df
 
-func NewConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+func NewConmon_capabilities_Params(s *capnp.Segment) (Conmon_capabilities_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_capabilities_Params{st}, err
+}
+
+func NewRootConmon_capabilities_Params(s *capnp.Segment) (Conmon_capabilities_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_capabilities_Params{st}, err
+}
+
+func ReadRootConmon_capabilities_Params(msg *capnp.Message) (Conmon_capabilities_Params, error) {
+	root, err := msg.Root()
+	return Conmon_capabilities_Params{root.Struct()}, err
+}
+
+func (s Conmon_capabilities_Params) String() string {
+	str, _ := text.Marshal(0x8ceb3503d8b127df, s.Struct)
+	return str
+}
+
+// Conmon_capabilities_Params_List is a list of Conmon_capabilities_Params.
+type Conmon_capabilities_Params_List = capnp.StructList[Conmon_capabilities_Params]
+
+// NewConmon_capabilities_Params creates a new list of Conmon_capabilities_Params.
+func NewConmon_capabilities_Params_List(s *capnp.Segment, sz int32) (Conmon_capabilities_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_capabilities_Params]{List: l}, err
+}
+
+// Conmon_capabilities_Params_Future is a wrapper for a Conmon_capabilities_Params promised by a client call.
+type Conmon_capabilities_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_capabilities_Params_Future) Struct() (Conmon_capabilities_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_capabilities_Params{s}, err
+}
+
+type Conmon_capabilities_Results struct{ capnp.Struct }
+
+// Conmon_capabilities_Results_TypeID is the unique identifier for the type Conmon_capabilities_Results.
+const Conmon_capabilities_Results_TypeID = 0xfaf066b0dfd2c1d5
+
+func NewConmon_capabilities_Results(s *capnp.Segment) (Conmon_capabilities_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Params{st}, err
+	return Conmon_capabilities_Results{st}, err
 }
 
-func NewRootConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+func NewRootConmon_capabilities_Results(s *capnp.Segment) (Conmon_capabilities_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Params{st}, err
+	return Conmon_capabilities_Results{st}, err
 }
 
-func ReadRootConmon_reopenLogContainer_Params(msg *capnp.Message) (Conmon_reopenLogContainer_Params, error) {
+func ReadRootConmon_capabilities_Results(msg *capnp.Message) (Conmon_capabilities_Results, error) {
 	root, err := msg.Root()
-	return Conmon_reopenLogContainer_Params{root.Struct()}, err
+	return Conmon_capabilities_Results{root.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Params) String() string {
-	str, _ := text.Marshal(0xe5ea916eb0c31336, s.Struct)
+func (s Conmon_capabilities_Results) String() string {
+	str, _ := text.Marshal(0xfaf066b0dfd2c1d5, s.Struct)
 	return str
 }
 
-func (s Conmon_reopenLogContainer_Params) Request() (Conmon_ReopenLogRequest, error) {
+func (s Conmon_capabilities_Results) Response() (Conmon_CapabilitiesResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ReopenLogRequest{Struct: p.Struct()}, err
+	return Conmon_CapabilitiesResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Params) HasRequest() bool {
+func (s Conmon_capabilities_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_reopenLogContainer_Params) SetRequest(v Conmon_ReopenLogRequest) error {
+func (s Conmon_capabilities_Results) SetResponse(v Conmon_CapabilitiesResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-// NewRequest sets the request field to a newly
-// allocated Conmon_ReopenLogRequest struct, preferring placement in s's segment.
-func (s Conmon_reopenLogContainer_Params) NewRequest() (Conmon_ReopenLogRequest, error) {
-	ss, err := NewConmon_ReopenLogRequest(s.Struct.Segment())
+// NewResponse sets the response field to a newly
+// allocated Conmon_CapabilitiesResponse struct, preferring placement in s's segment.
+func (s Conmon_capabilities_Results) NewResponse() (Conmon_CapabilitiesResponse, error) {
+	ss, err := NewConmon_CapabilitiesResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ReopenLogRequest{}, err
+		return Conmon_CapabilitiesResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_reopenLogContainer_Params_List is a list of Conmon_reopenLogContainer_Params.
-type Conmon_reopenLogContainer_Params_List = capnp.StructList[Conmon_reopenLogContainer_Params]
+// Conmon_capabilities_Results_List is a list of Conmon_capabilities_Results.
+type Conmon_capabilities_Results_List = capnp.StructList[Conmon_capabilities_Results]
 
-// NewConmon_reopenLogContainer_Params creates a new list of Conmon_reopenLogContainer_Params.
-func NewConmon_reopenLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Params_List, error) {
+// NewConmon_capabilities_Results creates a new list of Conmon_capabilities_Results.
+func NewConmon_capabilities_Results_List(s *capnp.Segment, sz int32) (Conmon_capabilities_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_reopenLogContainer_Params]{List: l}, err
+	return capnp.StructList[Conmon_capabilities_Results]{List: l}, err
 }
 
-// Conmon_reopenLogContainer_Params_Future is a wrapper for a Conmon_reopenLogContainer_Params promised by a client call.
-type Conmon_reopenLogContainer_Params_Future struct{ *capnp.Future }
+// Conmon_capabilities_Results_Future is a wrapper for a Conmon_capabilities_Results promised by a client call.
+type Conmon_capabilities_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_reopenLogContainer_Params_Future) Struct() (Conmon_reopenLogContainer_Params, error) {
+func (p Conmon_capabilities_Results_Future) Struct() (Conmon_capabilities_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_reopenLogContainer_Params{s}, err
+	return Conmon_capabilities_Results{s}, err
 }
 
-func (p Conmon_reopenLogContainer_Params_Future) Request() Conmon_ReopenLogRequest_Future {
-	return Conmon_ReopenLogRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_capabilities_Results_Future) Response() Conmon_CapabilitiesResponse_Future {
+	return Conmon_CapabilitiesResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_reopenLogContainer_Results struct{ capnp.Struct }
+type Conmon_limits_Params struct{ capnp.Struct }
 
-// Conmon_reopenLogContainer_Results_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Results.
-const Conmon_reopenLogContainer_Results_TypeID = 0xa0ef8355b64ee985
+// Conmon_limits_Params_TypeID is the unique identifier for the type Conmon_limits_Params.
+const Conmon_limits_Params_TypeID = 0x88a7c20d48426128
 
-func NewConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+func NewConmon_limits_Params(s *capnp.Segment) (Conmon_limits_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_limits_Params{st}, err
+}
+
+func NewRootConmon_limits_Params(s *capnp.Segment) (Conmon_limits_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_limits_Params{st}, err
+}
+
+func ReadRootConmon_limits_Params(msg *capnp.Message) (Conmon_limits_Params, error) {
+	root, err := msg.Root()
+	return Conmon_limits_Params{root.Struct()}, err
+}
+
+func (s Conmon_limits_Params) String() string {
+	str, _ := text.Marshal(0x88a7c20d48426128, s.Struct)
+	return str
+}
+
+// Conmon_limits_Params_List is a list of Conmon_limits_Params.
+type Conmon_limits_Params_List = capnp.StructList[Conmon_limits_Params]
+
+// NewConmon_limits_Params creates a new list of Conmon_limits_Params.
+func NewConmon_limits_Params_List(s *capnp.Segment, sz int32) (Conmon_limits_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_limits_Params]{List: l}, err
+}
+
+// Conmon_limits_Params_Future is a wrapper for a Conmon_limits_Params promised by a client call.
+type Conmon_limits_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_limits_Params_Future) Struct() (Conmon_limits_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_limits_Params{s}, err
+}
+
+type Conmon_limits_Results struct{ capnp.Struct }
+
+// Conmon_limits_Results_TypeID is the unique identifier for the type Conmon_limits_Results.
+const Conmon_limits_Results_TypeID = 0xbe34f78f6a935b18
+
+func NewConmon_limits_Results(s *capnp.Segment) (Conmon_limits_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Results{st}, err
+	return Conmon_limits_Results{st}, err
 }
 
-func NewRootConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+func NewRootConmon_limits_Results(s *capnp.Segment) (Conmon_limits_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Results{st}, err
+	return Conmon_limits_Results{st}, err
 }
 
-func ReadRootConmon_reopenLogContainer_Results(msg *capnp.Message) (Conmon_reopenLogContainer_Results, error) {
+func ReadRootConmon_limits_Results(msg *capnp.Message) (Conmon_limits_Results, error) {
 	root, err := msg.Root()
-	return Conmon_reopenLogContainer_Results{root.Struct()}, err
+	return Conmon_limits_Results{root.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Results) String() string {
-	str, _ := text.Marshal(0xa0ef8355b64ee985, s.Struct)
+func (s Conmon_limits_Results) String() string {
+	str, _ := text.Marshal(0xbe34f78f6a935b18, s.Struct)
 	return str
 }
 
-func (s Conmon_reopenLogContainer_Results) Response() (Conmon_ReopenLogResponse, error) {
+func (s Conmon_limits_Results) Response() (Conmon_LimitsResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ReopenLogResponse{Struct: p.Struct()}, err
+	return Conmon_LimitsResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Results) HasResponse() bool {
+func (s Conmon_limits_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_reopenLogContainer_Results) SetResponse(v Conmon_ReopenLogResponse) error {
+func (s Conmon_limits_Results) SetResponse(v Conmon_LimitsResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_ReopenLogResponse struct, preferring placement in s's segment.
-func (s Conmon_reopenLogContainer_Results) NewResponse() (Conmon_ReopenLogResponse, error) {
-	ss, err := NewConmon_ReopenLogResponse(s.Struct.Segment())
+// allocated Conmon_LimitsResponse struct, preferring placement in s's segment.
+func (s Conmon_limits_Results) NewResponse() (Conmon_LimitsResponse, error) {
+	ss, err := NewConmon_LimitsResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ReopenLogResponse{}, err
+		return Conmon_LimitsResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_reopenLogContainer_Results_List is a list of Conmon_reopenLogContainer_Results.
-type Conmon_reopenLogContainer_Results_List = capnp.StructList[Conmon_reopenLogContainer_Results]
+// Conmon_limits_Results_List is a list of Conmon_limits_Results.
+type Conmon_limits_Results_List = capnp.StructList[Conmon_limits_Results]
 
-// NewConmon_reopenLogContainer_Results creates a new list of Conmon_reopenLogContainer_Results.
-func NewConmon_reopenLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Results_List, error) {
+// NewConmon_limits_Results creates a new list of Conmon_limits_Results.
+func NewConmon_limits_Results_List(s *capnp.Segment, sz int32) (Conmon_limits_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_reopenLogContainer_Results]{List: l}, err
+	return capnp.StructList[Conmon_limits_Results]{List: l}, err
 }
 
-// Conmon_reopenLogContainer_Results_Future is a wrapper for a Conmon_reopenLogContainer_Results promised by a client call.
-type Conmon_reopenLogContainer_Results_Future struct{ *capnp.Future }
+// Conmon_limits_Results_Future is a wrapper for a Conmon_limits_Results promised by a client call.
+type Conmon_limits_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_reopenLogContainer_Results_Future) Struct() (Conmon_reopenLogContainer_Results, error) {
+func (p Conmon_limits_Results_Future) Struct() (Conmon_limits_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_reopenLogContainer_Results{s}, err
+	return Conmon_limits_Results{s}, err
 }
 
-func (p Conmon_reopenLogContainer_Results_Future) Response() Conmon_ReopenLogResponse_Future {
-	return Conmon_ReopenLogResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_limits_Results_Future) Response() Conmon_LimitsResponse_Future {
+	return Conmon_LimitsResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_setWindowSizeContainer_Params struct{ capnp.Struct }
+type Conmon_updateContainer_Params struct{ capnp.Struct }
 
-// Conmon_setWindowSizeContainer_Params_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Params.
-const Conmon_setWindowSizeContainer_Params_TypeID = 0xc76ccd4502bb61e7
+// Conmon_updateContainer_Params_TypeID is the unique identifier for the type Conmon_updateContainer_Params.
+const Conmon_updateContainer_Params_TypeID = 0xfd592f0d89b7b928
 
-func NewConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+func NewConmon_updateContainer_Params(s *capnp.Segment) (Conmon_updateContainer_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Params{st}, err
+	return Conmon_updateContainer_Params{st}, err
 }
 
-func NewRootConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+func NewRootConmon_updateContainer_Params(s *capnp.Segment) (Conmon_updateContainer_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Params{st}, err
+	return Conmon_updateContainer_Params{st}, err
 }
 
-func ReadRootConmon_setWindowSizeContainer_Params(msg *capnp.Message) (Conmon_setWindowSizeContainer_Params, error) {
+func ReadRootConmon_updateContainer_Params(msg *capnp.Message) (Conmon_updateContainer_Params, error) {
 	root, err := msg.Root()
-	return Conmon_setWindowSizeContainer_Params{root.Struct()}, err
+	return Conmon_updateContainer_Params{root.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Params) String() string {
-	str, _ := text.Marshal(0xc76ccd4502bb61e7, s.Struct)
+func (s Conmon_updateContainer_Params) String() string {
+	str, _ := text.Marshal(0xfd592f0d89b7b928, s.Struct)
 	return str
 }
 
-func (s Conmon_setWindowSizeContainer_Params) Request() (Conmon_SetWindowSizeRequest, error) {
+func (s Conmon_updateContainer_Params) Request() (Conmon_UpdateContainerRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_SetWindowSizeRequest{Struct: p.Struct()}, err
+	return Conmon_UpdateContainerRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Params) HasRequest() bool {
+func (s Conmon_updateContainer_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_setWindowSizeContainer_Params) SetRequest(v Conmon_SetWindowSizeRequest) error {
+func (s Conmon_updateContainer_Params) SetRequest(v Conmon_UpdateContainerRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_SetWindowSizeRequest struct, preferring placement in s's segment.
-func (s Conmon_setWindowSizeContainer_Params) NewRequest() (Conmon_SetWindowSizeRequest, error) {
-	ss, err := NewConmon_SetWindowSizeRequest(s.Struct.Segment())
+// allocated Conmon_UpdateContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_updateContainer_Params) NewRequest() (Conmon_UpdateContainerRequest, error) {
+	ss, err := NewConmon_UpdateContainerRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_SetWindowSizeRequest{}, err
+		return Conmon_UpdateContainerRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_setWindowSizeContainer_Params_List is a list of Conmon_setWindowSizeContainer_Params.
-type Conmon_setWindowSizeContainer_Params_List = capnp.StructList[Conmon_setWindowSizeContainer_Params]
+// Conmon_updateContainer_Params_List is a list of Conmon_updateContainer_Params.
+type Conmon_updateContainer_Params_List = capnp.StructList[Conmon_updateContainer_Params]
 
-// NewConmon_setWindowSizeContainer_Params creates a new list of Conmon_setWindowSizeContainer_Params.
-func NewConmon_setWindowSizeContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Params_List, error) {
+// NewConmon_updateContainer_Params creates a new list of Conmon_updateContainer_Params.
+func NewConmon_updateContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_updateContainer_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_setWindowSizeContainer_Params]{List: l}, err
+	return capnp.StructList[Conmon_updateContainer_Params]{List: l}, err
 }
 
-// Conmon_setWindowSizeContainer_Params_Future is a wrapper for a Conmon_setWindowSizeContainer_Params promised by a client call.
-type Conmon_setWindowSizeContainer_Params_Future struct{ *capnp.Future }
+// Conmon_updateContainer_Params_Future is a wrapper for a Conmon_updateContainer_Params promised by a client call.
+type Conmon_updateContainer_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_setWindowSizeContainer_Params_Future) Struct() (Conmon_setWindowSizeContainer_Params, error) {
+func (p Conmon_updateContainer_Params_Future) Struct() (Conmon_updateContainer_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_setWindowSizeContainer_Params{s}, err
+	return Conmon_updateContainer_Params{s}, err
 }
 
-func (p Conmon_setWindowSizeContainer_Params_Future) Request() Conmon_SetWindowSizeRequest_Future {
-	return Conmon_SetWindowSizeRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_updateContainer_Params_Future) Request() Conmon_UpdateContainerRequest_Future {
+	return Conmon_UpdateContainerRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_setWindowSizeContainer_Results struct{ capnp.Struct }
+type Conmon_updateContainer_Results struct{ capnp.Struct }
 
-// Conmon_setWindowSizeContainer_Results_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Results.
-const Conmon_setWindowSizeContainer_Results_TypeID = 0xe00e522611477055
+// Conmon_updateContainer_Results_TypeID is the unique identifier for the type Conmon_updateContainer_Results.
+const Conmon_updateContainer_Results_TypeID = 0xa8757cef51f9fba2
 
-func NewConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+func NewConmon_updateContainer_Results(s *capnp.Segment) (Conmon_updateContainer_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Results{st}, err
+	return Conmon_updateContainer_Results{st}, err
 }
 
-func NewRootConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+func NewRootConmon_updateContainer_Results(s *capnp.Segment) (Conmon_updateContainer_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Results{st}, err
+	return Conmon_updateContainer_Results{st}, err
 }
 
-func ReadRootConmon_setWindowSizeContainer_Results(msg *capnp.Message) (Conmon_setWindowSizeContainer_Results, error) {
+func ReadRootConmon_updateContainer_Results(msg *capnp.Message) (Conmon_updateContainer_Results, error) {
 	root, err := msg.Root()
-	return Conmon_setWindowSizeContainer_Results{root.Struct()}, err
+	return Conmon_updateContainer_Results{root.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Results) String() string {
-	str, _ := text.Marshal(0xe00e522611477055, s.Struct)
+func (s Conmon_updateContainer_Results) String() string {
+	str, _ := text.Marshal(0xa8757cef51f9fba2, s.Struct)
 	return str
 }
 
-func (s Conmon_setWindowSizeContainer_Results) Response() (Conmon_SetWindowSizeResponse, error) {
+func (s Conmon_updateContainer_Results) Response() (Conmon_UpdateContainerResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_SetWindowSizeResponse{Struct: p.Struct()}, err
+	return Conmon_UpdateContainerResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Results) HasResponse() bool {
+func (s Conmon_updateContainer_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_setWindowSizeContainer_Results) SetResponse(v Conmon_SetWindowSizeResponse) error {
+func (s Conmon_updateContainer_Results) SetResponse(v Conmon_UpdateContainerResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_SetWindowSizeResponse struct, preferring placement in s's segment.
-func (s Conmon_setWindowSizeContainer_Results) NewResponse() (Conmon_SetWindowSizeResponse, error) {
-	ss, err := NewConmon_SetWindowSizeResponse(s.Struct.Segment())
+// allocated Conmon_UpdateContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_updateContainer_Results) NewResponse() (Conmon_UpdateContainerResponse, error) {
+	ss, err := NewConmon_UpdateContainerResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_SetWindowSizeResponse{}, err
+		return Conmon_UpdateContainerResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_setWindowSizeContainer_Results_List is a list of Conmon_setWindowSizeContainer_Results.
-type Conmon_setWindowSizeContainer_Results_List = capnp.StructList[Conmon_setWindowSizeContainer_Results]
+// Conmon_updateContainer_Results_List is a list of Conmon_updateContainer_Results.
+type Conmon_updateContainer_Results_List = capnp.StructList[Conmon_updateContainer_Results]
 
-// NewConmon_setWindowSizeContainer_Results creates a new list of Conmon_setWindowSizeContainer_Results.
-func NewConmon_setWindowSizeContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Results_List, error) {
+// NewConmon_updateContainer_Results creates a new list of Conmon_updateContainer_Results.
+func NewConmon_updateContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_updateContainer_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_setWindowSizeContainer_Results]{List: l}, err
+	return capnp.StructList[Conmon_updateContainer_Results]{List: l}, err
 }
 
-// Conmon_setWindowSizeContainer_Results_Future is a wrapper for a Conmon_setWindowSizeContainer_Results promised by a client call.
-type Conmon_setWindowSizeContainer_Results_Future struct{ *capnp.Future }
+// Conmon_updateContainer_Results_Future is a wrapper for a Conmon_updateContainer_Results promised by a client call.
+type Conmon_updateContainer_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_setWindowSizeContainer_Results_Future) Struct() (Conmon_setWindowSizeContainer_Results, error) {
+func (p Conmon_updateContainer_Results_Future) Struct() (Conmon_updateContainer_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_setWindowSizeContainer_Results{s}, err
-}
-
-func (p Conmon_setWindowSizeContainer_Results_Future) Response() Conmon_SetWindowSizeResponse_Future {
-	return Conmon_SetWindowSizeResponse_Future{Future: p.Future.Field(0, nil)}
-}
-
-const schema_ffaaf7385bc4adad = "x\xda\xacX}\x8c\x15W\x15?\xe7\xde7\x0c\xcb~" +
-	"\xbc\xbd\x9dm$\x9b\x90U\x82M\x80\xd8\xa5lQ\xb3" +
-	"\xc1\xec\xc2\xb2Y\xb7\x82\xbe\xfb\x00\x09\x9f\xe9\xf0\xde\xed" +
-	"\xee\xd0}3og\xe6\xb1\xbbT\x02\xb4\x12c5U" +
-	"\x9a\x1a\x85H\x02Z\x9a\xb2\xb2R\xac`E\xd3X\x0b" +
-	"i\x8b\xa2\xee&\xd5\xd0XmE,\x12\xad\x1a\x9b\xb8" +
-	"5\x8dc\xee\xcc\x9b\x8f\xf7X\xd3\xfd\xf0\xbf}w~" +
-	"\xf7\xdcs\xce=\xe7\xf7;wW\xecU:S\xf7\xd4" +
-	"oY\x00\x84\x0f+\xf3<\xe7\xcd\x11\xfb\xa9\xe3=\x8f" +
-	"\x00[\x8e\x00\x0a\xaa\x00mLYL\x00\xb5\xa5J\x07" +
-	"\xa0w\xf8\xcf\x9f\xbe\xb0\xf9\x91\xbf\x9dH\x02z\x95\x95" +
-	"\x12\xa0\xfb\x80\xa3\xdbo>\xd8\xdd\x9b\xfe\x96\x04x\xff" +
-	"h{\xe0\xf5\xa37?\xf6\x03HI\xdca\xe5\x16j" +
-	"'\x15\x15\xa8\xb7\xe4\xec\x8b\xe3\x8f\xaen\x1dM\x9a\xd9" +
-	"\xaf\xdc!\xcd|\xcd73t\xff\xcbg\xf7\xf1\x1bg" +
-	"\xa60s^\x99@m\xdc7s\xef\xc9g/<\xf6" +
-	"\xf6\xf0w\x81/G\x12\xc3\x02{c\xca(j\x97\x95" +
-	"\x0f\x00hW\x95\xb3\x80\xdeC\xe3\xb7\x9e~\xecKk" +
-	"\xceK4V\xa3\xc5<B\xb4C\xf3T\x00m\xff<" +
-	"\x89\x8e\xbe\xb3%\xd4\x1b\x1b\xbb\xb4\xfd\xe3\xff\x1a\xf5\x00" +
-	"\xb0\xedNu\x1bjK\xd5\x97\x00\xda\xea\xe7\x7f\x01\xb5" +
-	"E5*\x80w\xe5\xc2\xe9\xf6\x7f_\x1f\xbaXm\\" +
-	"\x91\xc6\x95\x9a;\x88\xf6a\x89k\xfbP\xcd\x16\x04\xf4" +
-	"\x1a\xb7\xff\xf2\x13\x7f\xd9\xf5\xa7\xcb\xc9\x04\x9c[\xd0," +
-	"\x13\xf0\xca\x02\x99\x80\xb7\xf4\x1f\x91\xee\xab\x03/%\x01" +
-	"\x7f]p\x9f\x04\xd4\xd4\xfa\x80?\xfegO_\xb1\xf5" +
-	"\xe7\x01\xc0\xcf\xcc\xd2\xda\x09\x84\x947y\xe7\xf3_o" +
-	"^}\xf1\x17\xc9\xad\x8bj}\xdb\xab\xfc\xad\xcdk\xc6" +
-	"\xefM\x9b=\xbf\xaaLn\x00\xdcZ\xfb\x07\xd4J\xb5" +
-	"2\x0f\x83>\xf8\xbd\xc3\xab\x0f.Z\xf4\xebk\xd5\x81" +
-	"\x11\x89>R\xbb\x8ch\xe7|\xf4X\xed[\x80\xde\xb1" +
-	"\xe5C\xc5]\xbb\xdb\x7fW\x85\xf6\xdd\xfbb]3\xd1" +
-	"N\xd7I\xf0\xa9:\xdft\xfb{\xcf\x9fX]\xfc}" +
-	"\x95\x1fT\x82_\xa9\xbb\x82\xda\x0d\x1f\xfcf\x9d\xbc\x8f" +
-	"\xcd\xc5\x1evW\xb6\xe1\x8ddT\x87\xea\xb32\xaa\xe3" +
-	"\xf5\xd2\xda\x8a\x87zN\xef2\xb4\xebI\xc0\x0b\xf5\xaf" +
-	"!\xa0\xf6\xaa\x0f\xf8\xa8\xf6\xe23\xe6\x91[7\x92\x80" +
-	"w\xeb\x97I\x0b\xacA\x02^\xd8\xde\x96\xf9\xcd\xf5\xbb" +
-	"\xfe\x0el\x15\x89k\x0b\xb0\xed\x9e\x86\x09\xd464H" +
-	"gz\x1bZ\x00\xbd\xf1\xb7[\xce\xfc\xec\xc6\xa7\xfe9" +
-	"\xe5m\xf76\xbc\x86\x9a\x90\xe86\xbd\xc1\xbf\xed\xa7\x06" +
-	"\xbf\xfd\xd5\xc9\xc5\xec\x9d\xea:\xf5s\xf8\xc3\xf4b\xa2" +
-	"]KK\xe3\xaf\xa6e\x0e\x9f;\xf6\xc4W.\xad\xec" +
-	"y\xa7\"\x90F\xbf9\xae5J?\x7f\x8c\xa3\xb5;" +
-	"\xf6\xdc\x9c\xac\x08\xa41\x08\x84I\xc0\xe4\xc9\xef\xb4\x1d" +
-	"\xbc\xfa\xec\xbbSt\xcf*\xb6\x80h\x9b\x99\x0a\xad^" +
-	"\xce2\x0b\x96\xf9\x11[uZsV\xa1`\x99\xadE" +
-	"\xdbr\xad\xd6`\xfd\xee\x9c^4\x8b\xed]\xc1\x0f1" +
-	",r\x1bG\xcc\\\x97e\xba\xbaa\x0a{IF\xb7" +
-	"U\xbd\xe0\xf0\x14M\x01\xa4\x10\x80\xd5\xaf\x05\xe0\xf3)" +
-	"\xf2&\x82\x07l1X\x12\x8e\x8b\x8dq\xf0\x80\xd8\x08" +
-	"8\xa3cma\x15\x85\xb9\xde\xea\x8b\xcf\xcd\x8a\x16\xa7" +
-	"4\xe0V\x1c|\x1f\x00\xaf\xa3\xc8\x17\x12\xf4l\xe1\x14" +
-	"-\xd3\x11\x00\x80\x8d1\x1dU\x1d>o\x1a\x87g\xc3" +
-	"\xc3\xb3\xc2)\xa6\xa5\xcd\x0c\xce\xcc}\xddu\xf5\\\x7f" +
-	"E\xce\xf4\x02N#gQg\xcc\xc2\xed5\xfe\xa1\xd9" +
-	" \x0dX\xe1\xb32\x8d\xed\xeb\xad\xbeuv\xda\xd8+" +
-	"l\x9e\xc2dO\xe0\xb2\xf4\xa6\x91\xa2\xe0u\x91\xf3\xdd" +
-	"\xcb\x00x'E\xbe\x9e b\x13\xca\xb5^\xb9\xb6\x8e" +
-	"\"\xcf\x10d\x04\x9b\x90\x00\xb0\x0d2\xcaOR\xe4\x9b" +
-	"\x08\xa6\xdd\x91\xa2\xc0tl\x18\x10\xd3\x80\xe9\xa2\xee\xf6" +
-	"c\x1d\x10\xac\x03<P\xd0\x877\x1a\xfb\x04\xd6\x00\xc1" +
-	"\x9a\x19\xd6\xccF\xe1n1\xcc\xbc5$-d\x83\x94" +
-	"B\x061\xe9x\xf3\x14\x8e\xaf\x9c\xca\xf1\xf6\xd8qj" +
-	"\xe4C\xffZ\x86\x8c\xbc\xdb\x8f*\x10T\x01;\xfa\x85" +
-	"\xd1\xd7\xef\x86?#gS\xef\xe7,\xb5L\xbe\x02\x13" +
-	"l\xc2\xb6>\x1c\x0b\x09\xdbz1&!\xb63\x1bs" +
-	"+\xdb\xf9\xd3\xb8\xab\x98~%\xe6hfL$Xu" +
-	"\xd0N\xe8\xe8\xe0\xbe\x04\xef\x0f>\x9a\x10\xea\xd2\xe3\xb1" +
-	"4\xb2\x91\xd1\x04{\xec\xff\x9e\xf7Ya;\x86ef" +
-	"i\xd8V]\xb6\xd0]\x11\xd5t\xb6#\xc8\xb0\xe7\xd7" +
-	"\x8d\xb1W\x00\xda^\x88QBP\xb8\xb9\xbb\x9aF\xc2" +
-	"\xfb\x01/\xfcD\x12\xdf\xca%\xec\x85%\x0d-\xc1Y" +
-	"\xd1\xef\x8e\xc0\xae\x17\xb6*\xf6\xc5\x06\x93k\xa1\xa1\xb0" +
-	"60,\x8e\xb4o\xafz\xd9i\xf1\xcd\xf2\x85T\x01" +
-	"\x88\x94\x16C\x85a\xe7\xd6\x02a\xa7T\x8c\xb9\x1aC" +
-	"\xd1eG\x1f\x06\xc2\x8e\xa8H\xa2a\x0aC\xbef\x87" +
-	"\x1f\x07\xc2\x0e\xa9\x18\x0f@\x18\x0e\x02\xac$\xf7\x15T" +
-	"LE:\x85\xe1\xb0\xc5\xf4c@\xd8N\x15\x95h," +
-	"\xc0P\x0e\x19\xbf\x08\x84mP\x0f\xec\x0d.\xaa\x13\xbd" +
-	"\\9\xfbX\xce#t\xa2\x17\xf27\x86\xd9E\xbb\x13" +
-	"\xbd\x90\x9f\x92H;J[\x19J\x85\x84:\x15)\xea" +
-	"\xb2\xcc\x8e`K'\xce\x94\x14\xab\x0b\xc8\xbf0te" +
-	"\x87.\x8c:\xf4\xa8\xec\xd0'(\xf2\x13\x04Y\xd8\xa2" +
-	"\xc7\xb7\x01\xf0oR\xe4O\x13D\x12t\xe8)\xc9\xfd" +
-	"OR\xe4\xcf\x10d\x944!\x05`cY\x00~\x86" +
-	"\"\x7f\x99 K\xd1&L\x01\xb0\xcb{\x00\xf8%\x8a" +
-	"\xfc\x0d\x82LI5\xa1\x02\xc0^\x97&\x7fK\x91O" +
-	"V4\xb8\xb7\xbbd\xe6\x07DF\x07\x1a\xb3\x92\xe7\x0a" +
-	"\xbb`\x98\xfa\x80\x94\x16\x04\x82R\xda\xc5\xb0\xe1ft" +
-	"\xb7\x1f\xd0\xc1\x06\xc0\x0cE\x1f\xde\x00\xe8YV\xa1[" +
-	"~\x85\xb4\xee\xf6\xdf\xf6u \xec\x17jG\xdf\x1a\x93" +
-	"S\x87\x8f\x9a\x8b\xdad\x85S\x1a\xa0\xd3U\xca\x88)" +
-	"\xaa$g\xfe4Nv\x92\x94[%w\x0e\xc0\xfb\xeb" +
-	"]\xc4?\xb3\xd0\xbbr\xe1\x87\xe2:\xa3\x94\xe5*k" +
-	"q\x86)\x8b\x08yn\xc3\xc5`I\x15\x8e_\xff\x89" +
-	"S\x9b\xe3<%\xebr&\xc1MA\xb6\xf1\x1c\xc3\x1b" +
-	"\xa3\xb3t\x19\xe1\xfd\x14\xf9@\xac\x86\x86\x14\xbe<E" +
-	"^L\xa8aA.\xf6S\xe4\xae\xec\xb5\x0f\x06\xbd6" +
-	"(w\x17)\xf2\xcf\x91\xa0\x1b\xba\xac\xbc\x9f\x9f\x14\x10" +
-	"L\x01v8n\xde*\xb9X\x0f\x04\xeb\x83\x9f\xc2\xb6" +
-	"\xc3\x9f\x9ek\x14D\xfe3%7\xd9Ssb\x13y" +
-	"A4\x081\x91\xce=\x89K\xcc\x95\xc1\x90\xb63F" +
-	"\x1e\xe7\x03\xc1\xf9\xb3\x9c\xb1\xcaZ\xf3\xbf\xa7\x8b\x88\xbb" +
-	"z\xb7\xc5\x93\x04#e\xf2\xe26\x00\xcfP\xe4;*" +
-	"o\xd9\xb1r\x0f\x0a\xb7\x8a}|\x0a\x17\x8e\x03-\x86" +
-	"e\xf6\xde^\x12shT\xbf\xec]\x9cf\xd9G\xf3" +
-	"\xc1\x1c\x9auf\x8d\x16\x0dH\xff\x9f'DFO\xdb" +
-	"\xd3z\xbaD\x03\xd3,\"\x0d\xe7!\xfb\xeeM#E" +
-	"\x0c\xea\xd1\xbfte\x02 \xaaAbgK\xa6\xec\x81" +
-	"^\xd3\x15\xf6\x03z\x0e\xc5\x8cN\x09\xc7\xb3d\xd9'" +
-	"Tt\xedT*\xba\x18\x80\x7f\x83\"\x7f2Q\x89'" +
-	"\xdbcie\x94\x06\xad}*\x9b\xd0\xd6T*\x90\xd1" +
-	"\xb1\xddem}\x8e *\x81\x8a\x9e\x97\xc0\xefS\xe4" +
-	"?!\x18\x0e\"a}\xaa\xae\xde\x17\xfe\xdd!\xe31" +
-	"\xdc\x84\xca\x1a\x03\xf9u\xba\x0b(\xa25\xbb\xe4\xb82" +
-	"*P\x13F\xbc\xa2m\xe5\x84\xe3\xf4\x02\xde\xde\xb0\xb3" +
-	"\xe4\xc2\x98v\x13T([w\x07E\xde\x1fS\xa1\xd8" +
-	"6\x15\x15\xae-S\xe1\xe7e\xbe:\x83|\x1d\x92\x15" +
-	"|\x90\"\xffre;\xcb\xfb\xb5J\xeeF\xa0\"\x17" +
-	">i\x0eH\x97u3_=\x16L5c\xccE\xd1" +
-	"\xa6\xfd\xe4\x8c\xde\x1d\xb3\xe8\xb1\xdb\xff;\x90\x15Nz" +
-	"\xfa\xaf\xf4\xe8\xfd2\x8b\xb3\xab\x9e{\xa1\xd9\x0c\xe2\x7f" +
-	"\x03\x00\x00\xff\xff\xc9\xaaA("
+	return Conmon_updateContainer_Results{s}, err
+}
+
+func (p Conmon_updateContainer_Results_Future) Response() Conmon_UpdateContainerResponse_Future {
+	return Conmon_UpdateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+const schema_ffaaf7385bc4adad = "x\xda\xb4|}xT\xd5\xb5\xf7Z\xe7L\x18\x82\x84" +
+	"a\xb2'|D \x12\xa9\xd5(*\x09Q\x88\xa4\xf9" +
+	"\x82j(h&#\xd7\x8a\xc5\xeb\xc9\xe4\x90\x1c\x98\x99" +
+	"3\x9cs\x06\x08\x95\x07\xa1\xf2^\x01i\xc5\xb7~\xe0" +
+	"+-\xa8Q\xe0\xa2\x85V\xdb\x82Z\x0b\x95\xa7\x96\xea" +
+	"mIk\xad\xf6\"\xa2\xa6\x8a\xd5Z\xda\xda\x8aW\x98" +
+	"\xf7Y\xfb\xcc\xf9\x98\xc9\xb1f\x06\xee\x1f>f\xd6\xac" +
+	"\xd9{\xed\xb5\xd6^{\xed\xb5~\x9b\xcb\xd7\x965\xfa" +
+	"\xa6\x94\xa8\xe7\x83\x10y\x10\x8b\x86\xa4\xaf\xfe\xdd\xc6\x05" +
+	"R\xe8\xf6\xd5\x10\xbe\x181}\xa2f\xe1\x91\xcd\xef^" +
+	"\xf9#(\x12\xfc\x005[\x82U\x02{6\xe8\x07`" +
+	"{\x83\xdf\x03L\xbfP\xda\xfb\x87?O\x7fh5\x04" +
+	"/v3\x8b\xc4<\xaf\xf4\xaf\xc8\x96\x94\x12s\xbc\x94" +
+	"\x98\xaf\xd9x\xdbw\xce\xbb\xbcwM6\xb3\x8fx\x8b" +
+	"\xd8{\xc8&2?\x88\xe9\xb6\x0b\x8f>}\xfbs\xcf" +
+	"yq\x9d*\xfd\x04\xd9X\xce\xa5\x1f\xeb\xd1\x1e\xddr" +
+	"\xf57\x88\x0b\xa0\x08\xe9\xeb\x8fJ+\x05@V\xc2\x1a" +
+	"\x00\xd3\x0b\x0e\xfd\xf6\x9d\x1b>\xbdz-\x84\x9b\x11\xd3" +
+	"\x0f\x0f[\xb7\xbd\xe2\xff<q*\xc39\x99u\x08," +
+	"\xccH\xb4\xb9\x8cD\xbbPj\xbe\xa6\xe4\xc0cw\x98" +
+	"\xc3\xf1\xc9\xfa\xd9!\x04_z\xea\x0d\xb8\xfa\xde\xdf\xec" +
+	"]\x0f\xc1Z!=u\xdb\x0f~\xf8\xcd?/\x7f\x02" +
+	"\x00k^eU\x02;\xc9\xc7\xf8\x88]\x07\x98\xfe\xe8" +
+	"\x91\x17\xea\xef\xdb\xf4\xe1z\xb7H\xc1\xd0'\x08\xc8\xbe" +
+	"\x10\"\x91\xfe{Z\xd5\xc2\xad\xe2\x9c\x0dn\x86\xd6\xd0" +
+	"_\x89a\x01gxg\xe5\xfc?>\xf8_\xff\xbe\x81" +
+	"t/\xb8\xd4\xc99W\x86\xca\x05\xb6%4\x1a\x80\xf5" +
+	"\x86\xde\x01LW\xef\xfc\xeei\xe1\x8d\xdfo\x80`\x9d" +
+	"%\xf3\xa6\xb2f\x01|\xe9\x05\xa7\x7ft\xe4\xf5\x07\xfb" +
+	"\xef\xcc\xb5!\x1fg]\xd9\xf7\x91m+#\xb9\xb7\x94" +
+	"-\x03L\x1f\xfd\xe2\x9e\xdf\x8b\xb5\x7f\xba\xd3\xb5\xf6\x93" +
+	"e\x9f\xd0\xda\x1fiZt\xa85:a#\x04[\x04" +
+	"G8\xc0\x9a\x0f\xcaf\x0b,8\x8a\xc6(\x19Ek" +
+	"\xdfpqSx\xd8=\x0f\xdf\xe5^\xda\xc4Q\xe5d" +
+	"\x8e\xe9\xa3hi\xf7~8f\xbf\xb2\xe1\xd8&\xcf\xa5" +
+	"\xdd8\xeandK\xf8p\xf1Qd\x8e\x8bb/\xb4" +
+	"\x8e{\xe5\x8eo\xbb\x87+\x1a\xcd\xad;~4\x0d\xf7" +
+	"\xd1_\xefX\xf0\xda\xb7\xba\xef\xcd\xf1;\xce\xd84\xba" +
+	"\\`\xd2h\x1am\x01g~B\xed\xdc\xd5_\xfc\x1f" +
+	"\xf7\xbaG[9\xba\x94F\xbb\x873\x1c\x92\xaf\xdc\xf8" +
+	"\xadM\x07\xees3\xec5\x19\x0es\x86!\x0d\xbe\xaf" +
+	"\xfff\xdc\xb3\xf7\x93\xf4Cr\x9c\xf2\xa3\xd1\x87\x90\x05" +
+	"\xc7\xd0\x9f%cn@\xc0\xf4\x98\xdf\x1e\xff\xea7\xbe" +
+	"X\xf2\xff<\xd7*\x8f\xedC\xb6z,I\xb7r," +
+	"\xad\xb5\xfe\xe3\x03?\x7f\xe5\xde]\x03\xb8\xf9\x1e\x1a[" +
+	"~7\xb2)\xe5\xdcg\xcb+h\xf0\x0b?Z\xd5!" +
+	"\xbe?\xeb\xc1\x1cv.J\xd3\xb9}\xc8\x16\x9cKc" +
+	"\xdfx\xee2\xc0\xd3\x93\xde\xd9\xf0X\xcb\xbe\x07\x07\xee" +
+	"\xa4g\xcf-\x15\xd8\x91si'\xb5\x97\xae\xbd\xfe\xbe" +
+	"\xf65[\xb2\x16\x7f\xae\xb9\xf8s\x1b\x00O\xbf\xf8h" +
+	"\xed\xdf\x9aC[\x1d\xef8A\xdf\xfa\xd2\x9b7\xfe\xe7" +
+	"\xaf\xc47\x1e\xdb\xeae\x83\xe3\xe7\x0a\x02+\x1aG\x92" +
+	"\xe08\xd2`\xf5.\xff\x12\xf1\x0foz2O\x1c\xf7" +
+	"\x09\xb2z\xce<\x9d3\xaf=~\xed\x0f\xe7}\xe3\xc3" +
+	"\xadn\x91n\x1cWM\"-!\x86\xff\x99}\xf9M" +
+	"-\x077os}}\xcf8\x81\xbe\xde\xc1\x7f\xbf\xf9" +
+	"\xa6w\x17\xcfj\x0d<\xe45\xd9K\xe3\xdeCv\x9c" +
+	"O\xd6\xcf\x99\xf7\x1c\x9a\xdc\x1ek\xfc\xe5\xc3\x99\xc9x" +
+	"\x9c+\x1b\xcf\xd7?y<\xed\x8fQ\xdb\xd9w\xff\x18" +
+	"{\xe5Q7\xc3\xc6\xf1\xdc\xb7{9\xc3\xaa\x92\x83\xf7" +
+	"\x1c\xe9\x98\xbf=\xcb['p\x86\xf1\x13h\x8a\x87\xfe" +
+	"\xe7d\xf8\xc3[SY\x0cM&\xc3\x8d\x9c\xe1\x97\x9f" +
+	"\x86\x9eyw\xf2\xf5;<b\xde\xca\x09\xb4\xed'\x90" +
+	"\xa5&}\xefg\x87\xd7\xcf\xb8l\xa7{\x98\x9e\x09\\" +
+	"\xd2M|\x98}\xdf\x0b\xbf\xfd\xa7\x07\x1e\xcdb\xd83" +
+	"\x81o\x9b_p\x86e\xb7\xbc\xf0\xbd\x15\xe1\xfe]\x1e" +
+	"\xf3|0\xa1\x0fYq\x05\xcds\xea\xe8\xaa\xd1W%" +
+	"n~\xdc=L\xff\x84*\x1a\xe6\x14\x0d\xf3\xcf\xd3\xcf" +
+	"N\xe8\x1fv\xf3\x13\xae\xaf\xc7W\xf0\xd5\xd4V\xd0," +
+	"v\x94\xf4\xdc\xeb\x15;\x91-\xa9\xf8\"\x00[WA" +
+	"a\xacwM\xe8\xe7OO\xc5\xdd^G\x88t\xde\x9b" +
+	"\xc8V\x9eG\xc6\xea9\x8f6\xcb\xafzc\xc1\x9dS" +
+	"\xaf\xfcA\x0e3\xb7Ipb\xa9\xc0\xa6L$\xe6\xc9" +
+	"\x13\xc9.\xf3kjw\\v\xc1\xb5?p\xaf\xe3\xf1" +
+	"\x89|\x1d\x07'\x92\xa0\x1f\xde\x7fj\xcc\xa1\xfe\xde'" +
+	"==\x98F+\xae\xa4\xd1\x8a*\x89\xf9\xeb\x87\xdf\xdb" +
+	"\xfe\xcd\x0dMOy\x06\xd5/T\x0a\x02k\xe2\xdc\xf5" +
+	"\x95$\xa8\xfd}p\x92\x98~\xfc\xf1\xe7o\x9a\xf6\xcf" +
+	"\x9di\x0a\x9d/W\xce\xc7\x9a\xe3\x95\xef\x08tB^" +
+	"\xf0\x1fElz\x95\x1f\xe0\xb9\xb7oo|\xfb\xfcK" +
+	"\xf6\x06/\x16\x9d\x9f\x02\xd6L\xac\xeaCV_\xc5\xb7" +
+	"F\xd5\xd5L\xa2\xbf\xd2\x87~\xb8\xa3\xee\x93\xb7\x96\xed" +
+	"#I\\\xecE#\xf9\x81RU*0\xa5\x8a\x87\x9a" +
+	"\xaa\x0f\x8b(\x1c\xdd\xf4\x7f\x17}\xeb\x9fS\x7f\xe2V" +
+	"C|J\x1f\x1d;\xab\xa7\xd0\xca>\xf8\xceM[\xbf" +
+	"\xf2\x93\xee\xfd\xb9\xe3q\xad\xf6N)\x15\xd8\xc1)\xf4" +
+	"\xe7\xfe)<\x02=\xfc\xb3\xbb\xc3w\xbd\x1f{\xde\xc3" +
+	"\x89\x8eT\x97\x0b\xecT59\xd1\xc8\x9b~U\xff\xfe" +
+	"\xcd\x7f<\xe8\x9e\xf5\xd5j\xee%'\xaa\xf9a'=" +
+	"-\xccz)\xf6s7CY\xcdlb\x98RC\x0c" +
+	"\xef\xcf}\xf1\x9b}\xe3\x93\xbfp3\x84kx\x14P" +
+	"8\xc3{\xd17\x8e\xfe\xf0||\xd1\xcb\x196\xd6T" +
+	"\x0a\xec\xf1\x1aR\xdc\x8e\x1ar\x86w\xde>\xbd\xa8+" +
+	"y\xd9\x8b\xaeS\xaelj\x1f\x9drM\xff\xd8\xf5s" +
+	"\xe1\xda\xdf\xbf\xe4\xe5\x05\xc5S\xeb\x04v\xd1T\x1a\xe6" +
+	"\x0bSi\xce\xc5\xe7\xbc\x10*n\xd0\xff++4M" +
+	"5C\x13g\xf8\xb8\xec'\xf7\x95\xcf\xd8\x97\xc5\xb0i" +
+	"*_\xf7\x0e\xceP\xdetxj q\xf5\xaf=\x83" +
+	"\xd3\xd47\x91\x1d\xe7\xd3\xf5s\xe6\xa3\xafL(n\x95" +
+	"\x7f\xd9\x97\x15Zj\x87\xf1\xd0RK\x0c\x0f\xffm\xfb" +
+	"-Om\x0a\xfd\xce\xd3+\xebkw\"\x9bWK)" +
+	"\xc3\x82ZR\xc2\xa7kg\xdc6~\xfc\xef^\xf5L" +
+	"\xee\xf6\xd6V\x09\xec\xd5Z\x9a\xfc\xe5Z\xda\x99\xc6?" +
+	"f\x8d\xbf\xf8\xa2\x11\xafAp\x8a\x90\xbe\xe7\xf9\x7f\xbb" +
+	"\xadi\xf43\xef\x91W\xee\xb9\xe2\xfb\xc8~q\x05q" +
+	"\x1e\xbc\xa2\x0b0\xfd\xfc\xa2\x11\xcf=h|\xed\x88\x97" +
+	"%\x8e_AG\xc1\x95\xfc(\xb8\x92\x84x\xe0\xe2e" +
+	"\xc9\x9b;\xea^\xf7\x14y\xf5\x95\xe5\x02\xdb\xc6\xb9\xb7" +
+	"p\xee\xdbv\xady\xac\xef\xcf\xfb^wk\x00\xa7\xf1" +
+	"\x986v\x1ai\xe0\xd4\xf0\xd0\xa7\xe7\xff\xe6\x0f\xaf\xf3" +
+	"\x04\xcdV\x07`M\xfd\xb4>d7N\xa3\xa1\xe6M" +
+	"\xa3=\xf9i\xdd\xa7?\xd9:#y4wb\x1ej" +
+	"vL;\x84\xec \xe7\xde?\x8dV\x7f\x7f\xc9s\xdf" +
+	"y\xfb;\x87\x8e\xba\x1cf\xdb\xf4at\xf0\xcdK^" +
+	"\x1d\xbc\xa0}\xc4\x1bn\x916Oo'\x91\x9e\x9aN" +
+	"\"\xad\x7fk\xf6\xf9)\xf5w\xc7\xb2|\x7f:\x97\xf9" +
+	"\x04g\xb8\xfc\xebW\xef\xb8Yaoe\xa5\x8au\xaf" +
+	"\xf1T\xb1\x8e\x18~\xad\xfbOm\xb8\xe4\x92\xb7\xbc\x9c" +
+	"\xa4\xb5\xaeZ`J\x1dI*s\xe6=\x17W\xf5\xbf" +
+	"\xb5cL\xbf\x97\xf6\xd7\xd6\x95\x0b\xac\x973o\xab#" +
+	"}^\xc1~\xb6;\xb1\xe9\xbd~\xf7\xd4\xa7\xeaxP" +
+	",\xbb\x8aF;\xb2&1\xf7\xd8\xa9u\xc7\xdd\x0c\xb5" +
+	"Wq\x07\x9e\xcb\x19lO\xf0\x0a\xef\xf1\xab\xd6#[" +
+	"{\x15\xb9\xdc\xc6\xabH\x8d{/\xbe\xe0\x89wV<" +
+	"\xf3'Ok+3^C\xb6v\x06I\xb7z\x06I" +
+	"\xf7\xf4\xd7O\x8c\xd9\xdd\xdf\xf7\x81{\xf2c3\xf8\xe4" +
+	"'g\xd0\xe4\xfbo\xaai{\xe5\xad\x0b\xfe2 \x1d" +
+	"\x1f_\xdf\x87lz=\x0dU[_\x01\x98\xfeJ\xe3" +
+	"O\x0f\x8d?\xbc\xe1D\xd6:\xeay:\xdeZOC" +
+	"\x1d\xfes\xc5\xae_\xf6\x7f\xe5o\xb9\x92\x15q\xc9\xea" +
+	"I\xb2z\xee\x92\xf5<\xab{t\xc9\xc3w}\\\x19" +
+	"\xfc{\xee\xb2\xfd\xdc\xba_\xaa\x14\xd8\xc9/\xf1|\xf0" +
+	"K\x8f\x09\x80\xe9\x1f?\xf0\xedo=_}\xf5\xdf\xb3" +
+	".(M\xfc\xb0.i\xa6\xe9\x8f\xab\x87\xab\x9f\xfe\xe3" +
+	"\xe6\xbf{\x99xrs\xa5\xc0\xe66\xd3bZ9s" +
+	"\xd9\xbf\xaf~\xa3\xea\xf8[Y\xa3\xf54\xd7\xf1\xa3\x9f" +
+	"3<\x83;\xcf\xf9\xda\xa2w?v3<\xd5\xcc\xcd" +
+	"\xfa\x12g\xf8x\xdb\x7f\xd6\xdc\xf6\xd2\x0fNzD\xed" +
+	"\x13\xcd\xc3\x04\x16l\xa1\xa8}\xf7of\xc7_?\xf5" +
+	"\xf4'^\xae\xf4A\xf3\x9b\xc8\x8a[\xf8\x89\xd8B\xc6" +
+	"zy\x7f\xdf\xd1\xdd\x0b\xff\xf2\x89{N\xa9\x85\xe7a" +
+	"\xa9\x16\x9a\xf3\xc2\xbd?ZWr\xd9\x8d\xa7\xb26J" +
+	"\x0b\xd7\xc1\x1e\xce`_\xcb<tp\xb8\xa5T`'" +
+	"Z\xc8\x93Nq\xe6\xfbv\xdf\xf1\xfe\x93\x1bw\x9f2" +
+	"\xef;&\xd3\x94\x99\\\x07\xad3\xf9\x12\xbf\xfb\xde-" +
+	"\x97\\\xf7\x8f\xd3^\xc2+3\x87\x09l\xddL\x12~" +
+	"\xed\xcce09\x1dU\x13q51Y\xf3\xeb\x97E" +
+	"\xd5x\\M\\\x96\xd4TC\xbd\xcc\xa4_\x1a\x95\x92" +
+	"\x89d]\x8b\xf9\xa1=\x95hO%\x0c%.\xb7\xa8" +
+	"\xf1\xb8\x94\xe8l\x97\xf5d@M\xe8r\x1bbx\xb8" +
+	"\xe8\x03\xf0!@p\xd6l\x80\xf0L\x11\xc3m\x02\"" +
+	"\x86\x90hs\xeb\x00\xc2\xd7\x88\x18\xbe^\xc0\xa0\x80!" +
+	"\x14\x00\x82a\"\xce\x111\xfcU\x01\xd3\xf2r\xc5h" +
+	"Q;e\x00@\x1f\x08\xe8\x03l\xd0\x8dN5e`" +
+	"\x09\x08Xb~\x945\xcd\xfah\xcb>d\x10\xb2G" +
+	"\x96I\xc99jW\x9bdt\xb7\xcb\x15KR\xb2n" +
+	"\xe4\x08]\x0e\x10n\x141<G\xc0\xa0%uk\x95" +
+	"\xb3\x92\xa0 \x98R\xcfmv\x96\"*\x9d8\x1c\x04" +
+	"\x1c\x0e\x18HJF\xb7\xf5aUB^\xd6\xe6\xfa\x9c" +
+	"\x9f\xac\xb2\xd1\x92LEd\xc3\xad\xdf\xc2W\xdb\xa0'" +
+	"s\xc7\x18\x8c\xb5\xe5\xe5r4\xd2\x93\x88\xb6\xa8\x09C" +
+	"R\x12\xb26\xa9M\xd2\xfcR\\\x0f\xfbl\xa5\x95\x90" +
+	"*\x86\x8a\x18\x0e\x09\xb8J\x93\xb9Zq\xa4\x13#\x00" +
+	"q\xa4k\xf1C\x071\xed\x1cE7\xec)u\xd2\x00" +
+	"\x09\x7f\xa9I\xf2'd\xed\xb3\xcdf[\xad\xd2\xcbj" +
+	"\xd5\x9eV\xf3'\x95N\x1c\x0a\x02\x0e\x05\xac\xd0\x0d\xc9" +
+	"\x901\xe0\\\xf8\x011\x90\xa7\xf1bJ\\1t\xd2" +
+	"\x95\x14\xd7\x01\xf2R\xf9\x1c\xb5k\xa6\xa6,\x95\xb5K" +
+	"\xafW\xe2\xb2nH\xf1\xe4\x97\xd5\x80\x16\x97L_\xe5" +
+	"\xeb\x18\xdfAR\x05\xc76\x03\xa0\x10\x0c\xae\x00Hk" +
+	"\x0b\xa3555\xd3\xaf\x05\xbf\x94PWe>9\xd4" +
+	"\x06)\xa1\xce3\xa2y\xadBw\x1chR{\x83\xac" +
+	"\xa7bF\x96\xddi\x87\x0f\x171<F\xc0\xb4\x96\xb1" +
+	"\x11m\xdc\x91N\xdd*\xc7\xf6\xf9\xce\xdaV\xc15\xf8" +
+	"\xf9\xcef\xd7\xder&\x1c\x8c\xc2mG\x8b\x18\x92\x91" +
+	"\xcax\x9b\xa8\xcba\x1f\xa2\xab\xec\x83\xd5\x15\xc4 \x87" +
+	"G\xda\xc2H\xe4N_\x131\xdc\xed\x0a\x1729\xde" +
+	"-\"\x86c.\xc7SHW\xdd\"\x86\x0d\x01Q\x0c" +
+	"\xa1\x08\x10\\\xd2\x01\x10N\x8a\x18\xbeS\xf8L\xafs" +
+	"{\xa6WpL'55*\xebz\x13\xf8\xb5.\x1d" +
+	"G\x00\xb6\x89\xc8\xddz\x84K\x0b#\x06\xa1\x85YK" +
+	"\xe5\x84\xa1\xb7H\xb1X\x87\x14]|\xa9L\x1f'\xb5" +
+	"\x9bF\x07k\xa0\xc1\x9c\x0f\xaa!\x19\x15r'\x99\x10" +
+	"1<\xd4V\xd6E\x14F'\x89\x18\xbe\xdc\xd9\xa4\x93" +
+	"\x89v\xa1\x88\xe1\xa9Bv\xe8\x0c\xe8\xca\x0a\x19\x8bA" +
+	"\xc0\xe2<\xdd'*%\xa5\x0e%\xa6\x18\x8a\xacOj" +
+	"0w`^\xb1\xc7\xd3\x1dt\xf9Rn|\x00\xd7&" +
+	"l\xe6\x9b\xb0\xcc\xdc\x84%u\x00\xab\xa2\x9a,\x19r" +
+	"\xe7*-\x95H(\x89\xae\x06\xb2\x98\xdc\x99\x977j" +
+	"\xb2D\xba\xcb\x0a\xb8\x928\x98=`\x97\xdar\xf6@" +
+	"\xd1`\xacF\x11\x0bsO\xc3*\x8f\xb0\x9au\x18f" +
+	"\x8e\xf0\xb9UNX\x0d\x18=I\xd96c\xb7\xa4u" +
+	"Zf\x0c\xe8\xeaBc\x80M\x07\xa3\x93\xa8\xa5\x8cV" +
+	"\x95\xac\xa0s\xbf\xf4\x0f:\x1a\xd9\xd9\xe5\x99\x04\x87\xcc" +
+	"\xd4\xed\xa4n\xd1\xcc\x1b\\\x93\x97;\x16q\x9d+y" +
+	"\xcd\x14\xcb:\xf32[\x0f\x07\xb9D;y,`\x89" +
+	"\xba!i\xce\xcc\xf9MlUU\x0b\x88\xf3\xcd15" +
+	"\xba\xb8U\x9d)/U\xa2\x99}5\xc6\x9eq3E" +
+	"\xd6o\x8b\x18\xde\xea\x8a\xac[\x88x\xbf\x88\xe1G\\" +
+	"\x91u[;@x\xab\x88\xe1]\x02\x06\xc5Lh\xdd" +
+	"1\x1f \xbc]\xc4\xf0\x93\x02\x06}\xbe\x10\xfa\x00\x82" +
+	"{h\xdb\xec\x121\xfcc\x01\x83EE!,\x02\x08" +
+	">EK{R\xc4\xf0O\x05\xac\x88K\x8bT\xcd\xf2" +
+	"\xd0\x8a\xb8\x92p>\xa5i_6\xf7\x182\xa0n\xd3" +
+	"\x96i\x8a!7\xf7\x18 \xca6q\x151^\x97\xcc" +
+	"a\xba.\xa9\x93\xbe\x0a\x09h\xedf@\xe0\x8e\xa7\xa3" +
+	"1\x88\xcc\xc7s\x8bjN\x96\xed\xceW\x0dI\x89\xd9" +
+	"b\xe9J\"*\xcfK(P\xb1\xfcZ)\xa1b\x11" +
+	"\x08X\x94g$\xe1\xf6D~\x19\x08\xd9b\xae$\x91" +
+	"\x96\x8b\x18\xbe\xdde\xce\xd5D\xbcU\xc4\xf0\x1d\x02b" +
+	"\xc6\x9ak\xc9\xc4\xb7\x99gbPD\xd3\x9a\xeb\x88x" +
+	"\xbb\x88\xe1\xbb\xc8\x9a\x82i\xcd\x8dtz\xde)b\xf8" +
+	"\xfe\xdc\xa3\xc3\x1d\x802&\xcd,$cRkYI" +
+	"Y\x8b+\xba\xae\x80_M\xe8\x05%\xe9sx\x9e\x97" +
+	"9\"\xccE\xbb\x0e\xbc\x15\xce\xe1\x16\xa4\x05\x12q\x0a" +
+	"\x11/\x171<C\xc0t\\Z>W\xd6u\x09\x1a" +
+	"\xba\xe4\x88\xfb\xc0\xebV\xd5\xc5\x94\xfdA\x83\x9a2\"" +
+	"r\xd4N\x01\xf2\xd9\xd7\x91\xac}\xed\x16\xb2\xb0\xe0k" +
+	"\x9e\x87fR\x8b\x838\x8f\xec\x8ar!1I\xd6\x96" +
+	"\xca\x9ay\x0e.T\xba\xacI\xf3\x1a\xa3\xc90\xa4h" +
+	"wsj\xe1B{\xf52\xe4\xc4\xed*G\xf2@\xa7" +
+	"dH\x05]+\xb3&j\x90\xed{\xe5\xd9=\x1f4" +
+	"YM\xca\x89\xac\xd4\xa0]\xae\xc8#)\xb7;A\x05" +
+	"\xd8Cr-\xd1\xce\x0a\x079\xad\xd5\x1a+\xe0\x8ch" +
+	"\xb7\xd6\x9cUd\xf0\xb0\xdf$\x01\x031\xd5\xc9\x80G" +
+	":}_\xc0\xac\\xP\xde\x97\xea\xd0\xa3\x9a\xd2!" +
+	"\x9bI\xb1\xed\xf2\xae\xbd\xdd\xec$\xb3v@\x9bLJ" +
+	"\xb8D\xc4\xf0\xb4\xac}`\xf7i,\x05drlR" +
+	"O\xd0jvP&\x99\xb7C\xe8\x86\xaa\xc9\x033\xc5" +
+	"\xcf\x13s\x85\xa7\x98v'\xc5\x12\xb3[\x8e.N\xaa" +
+	"\x0a4$\x0c\xcf*F!\xfb\x98\xbb\x8e8X\x8f\xb5" +
+	"\x8b\xb9\x05xl*\xd9)\x19rNZ3\xe8\x99\xed" +
+	"\xbeN\x013G\xb2\xbd\xc7\xbeO\xe6\x1bx\xcd=\x97" +
+	"m\xdeA\x05^\xbb\xb6_\x80\xf0\x03\x9c*\xbfT\xdb" +
+	"\xeeg\x14\xb0\xd9\xcd0Z\xf09\xa5[\x053\xb7\xc6" +
+	"\x02\x83+\x1f\xd8}\xd7\x024\x96{>\xe6\xe7h." +
+	"$I\xde\x976^)\x0a(Ke-<\x14\xdd\xed" +
+	"\x80\xe2*\x17T\xa7hM\xe0\xfa\x9e\xa4\x9c\xb6\xaaI" +
+	"\x98\xfc\xb2\xaa\xc5%\x03\xdc\x85\x8c*\xa7\x90a\xd71" +
+	"\xaa\xdcu\x8cL\x1a\xa9\x90\xfe:E\x0c']\xf9Y" +
+	"|\x0d@8&bx\xb9u\xfd\x0b8\xc2\x98u\x8c" +
+	"\xec\xbah\\Z\x9e\x95\xee\x18\xb9\xa2a\xc0Y@\x01" +
+	"\xe57W\xedtI\xca\x7f&U\xde*\xef*o4" +
+	"\x99\xb2\x13\xc6@\\\x8e\xeb\x05\x05\xc7\x01\x81\xc2\xc9\xf0" +
+	"]!|Q&\x8b\x9c\xe9\x12\xb6\x892\xe2\x19\x99\x9a" +
+	"\xb9\xe5\x80\x10\xd0Z;sk@\x15d\x0f\x9b\x18p" +
+	"\xfa\x98\x05\x1c\x8bZn\xe9\x9f|=0\xf8\x04\xc4\x86" +
+	"\xd3\x9d\x8d\"\x9d\xa3\xab\xb3\x9bfEd\xe3\x06%\xd1" +
+	"\xa9.#\x0f5\xa71`\x10\x97\xaej\xafKW\x9d" +
+	"\xa7\xfbT,S:\x8dn\xf4\x83\x80~\xc0\x86nY" +
+	"\xe9\xea6\xac\x8f\xb6\xb0\xbe\xcf\x13VT\x13\xe1\x1f\xa3" +
+	"\xabw\xc7\x14\\\xe3\x1c\xe3L\xc1}N\x0f\x8b\xc5q" +
+	"\xbd\x03\xafbK\xb0\xce\xe9\x18\xb28\xb6;\x91\x9b\xc5" +
+	"\xf1\x80S\xbcgK\xf0\x90\x03Xc=X\xe74\xce" +
+	"Y\x0a\xfb\x9c\xc3\x86\xadD\xcd\x01\xd5\xb0\x95\xb8\xc2i" +
+	"\xef\xb3\x95\xb8\xde\xc9?\xd9j\xbc\xdb\xc9\xcf\xd8Z\x9c" +
+	"\xef\x00J\xd8Z\xdc\xe9\xf4\xe7\xd8:\xfc\xbeS\xdbe" +
+	"\x1b\xf1\x01\xa7\xb4\xcc6\xe1CNo\x98\xdd\x83\xaf9" +
+	"\x1do\xb6\x05\xdb\x1d,\x03\xdb\x82o:\xd51\xd6\x8b" +
+	"+\x9cN,\xeb\xc55N\x86\xc6zq\x9f\xd3\xd3e" +
+	";\xb0\xda\xca\xcf\xf8\xaflh\x12\xeb\xc5\x03\xce\xfd\x86" +
+	"\xed\xc0}N g\x8f\xe3\x01\x07\x93\xc7\xf6\xe0\x01\xa7" +
+	"\x00\xc5\x9e\xc2C\x0e\x80\x8e=\x8b\x9as\xf4\xb0gq" +
+	"\xbd\x03\x0be\xfb\xf1n\x07\xd4\xc1\x0e\xe2!g\xfb\xb0" +
+	"\x97\xb0\xcfi\xf0\xb1\x97\xf1\xfbV\x19\x86\xbd\x8a\xfb\x9c" +
+	"\xac\x89\x1d\xc1\x03Ne\x88\x1d\xc3}\x0e\xf4\x8c\xf5\xe3" +
+	"CNj\xce\x8e\xe3N\x07\xb8\xc0>\xc0\x9d\x0e\xb6\x8e" +
+	"\x9d\xc0\x15\x0e,\x86\x9d\xc0}Nv\xc4>\xc2\x03\xe9" +
+	"\x7f\x935]Q\x13\xed\xa2\xb5\xd9[x\x15\xd4\xb9y" +
+	"f\xaeD\xe9\xeb\xe5\xe5\x06\xfd\x87s\xa5\xe4\xac\x84\xa1" +
+	"\xf5\x004\x98\xe5\x9f\xb4\xd5\xf4\x00\xd4\xd2\xd6\xcf\x8b\xb2" +
+	"o\xae2\xcc\xcamFY\xdb\x13\x1ax\xfd\xd2H[" +
+	"\x1c\xc2\xc0ko\xdaJ/\xc0l\xfc9\x9f3\xad\xb1" +
+	"\xb4u\xd9\xc0.{\xdc,\x9a5\x10\xafp\xcb\x9ds" +
+	"@T\xbb\xd2V\xb8@+^\x04\xf8\xe0\xb9d\xbd\xc2" +
+	"\x9c\xc3\xea\xc9!o\xca\x99\xa5\xc4\x1c\xa2\x9eT\xfd\xc4" +
+	"j-A\xd0\xae\x95\xe2\xb2\x9e\x94\xa2\xb2]}L[" +
+	"$\xc0\xcfb\xb4\x86\xb1\xcaG`Y\xc1\"\xd8\xe6\xca" +
+	"=\x862\x8c\x15\xfcc\xda\xea\x0b@\x83ykI[" +
+	"\xec\xbe\xac\xfc\x96\xcc\x9e\x13\xa2\xad\x09-\xfa\xd0\x9c\x8a" +
+	":x\xd6Vu\xc3\x83n\x9d\"i\xabh\x08\x15\xa6" +
+	"\xdfX'=\x1a\x8e\xcd\xdc4\xdbf\x99\xa3Kp\xb5" +
+	"\xadm\x1b{|g\xfd\xce\xaa\xa1\xa0\xe5M\x19\xe7\x19" +
+	"@\xb7\x9c(\x92\xb9\xf0\xf827\x1e[\xf6\xdc\x96\xa6" +
+	"\xf5\x03\xabx\x80f\x99\xc2\xccWr\xa9\x99\xcbo\xba" +
+	"%\xd3\xdc@C1ml\x92\xad2\x94\xed\xca\xf32" +
+	"\x97\x1f\xcc\xdd\x82\xd6\x17B\xee\xde\x0a\x7fU,\x02\xb0" +
+	"\x91ah\xa1r\xd8\x1e\xa1\x19\x04\xd6+\xf8\xd1\xc1q" +
+	"\xa0\x05\xecb\x9b\x855 \xb0M\x82\x1f\x05\x1b\x86\x8e" +
+	"\x16\xfe\x82\xad\x15\xee\x06\x81\xad\x16\xfc\xe8\x006\xd1\x02" +
+	"\xc3\xb1\x14\xffm\\\xf0\xa3\xcfF\xe2\xa0\x05we\x92" +
+	"\xf0\x00\x08l\x81\xe0\xc7\"\x1b\x1e\x87\x16\xda\x88\x85\x85" +
+	"} \xb0\xb9\x82\x1f\x87\xd8Pr\xb4@\xe7\xacI\xe8" +
+	"\x00\x81M\x17\xfc\xe8\xb7Ajh\xc1F\xd8d\xe1!" +
+	"\x10\xd8E\x82\x1f\x87\xdaXm\xb4\xa0Ul\xbc\xb0\x1e" +
+	"\x046V\xf0c\xb1\x0d\x87E\x0b\x8a\xc3J\xb8\xccE" +
+	"\x82\x1f\x87\xd9`a<\xfd\xec\x04\xe8\x1fv\xf3\x13\xec" +
+	"$\xd2\xb7'\xd0\x8f\xe7\xd8HY\xb4\x80\xa8\xac\x1fi" +
+	"\xe4c\xe8\xc7\xe16\xb0\x08-t7{\x99\x7f{\x18" +
+	"\xfdXb\x83N\xd1\x82\xfd\xb1\x83H\xda\xd8\x8f~\x1c" +
+	"a#\xaa\xd0\xc2t\xb2\xa7\x90\xf4\xbc\x07\xfd\x18\xb0Q" +
+	"rh\x01\xb9\xe9\xe4\x02\x81mA?\x8e\xb4\xf0\xcb\x0e" +
+	"L\x97m\xe22\xafC?\x06m\x98\x17Z(q:" +
+	"\xc5A`)\xf4c\xa9\x0d\"\xc2\xd9\x97\x03\x87\x1c3" +
+	"\x05\x17\x81\xc0$\xf4#\xb3\x91\xf3h!a\xd8<\xfe" +
+	"\xed\\\xf4c\xc8~S\x80\x16\x00\x935a\x1d\x08\xac" +
+	"\x16\xfdXfcc\xd0\x02\x07\xb3\x8b\xb8T\x13\xd1\xbf" +
+	"j\xa9y\xba4b:\x9a9\x17,\xa7\x86FL[" +
+	"\xf8\x04\xb4\xb6\x16j\x8d\x98\xb6n\xcenN\xcd\x8e\xe4" +
+	"\x19VQ&V=+P\xb7\xa8\x89\x06\xf3'\xf4U" +
+	"&,\x83_2\xba\x1b\x9d<\x1b\xad8\xeb\x8f\xcaz" +
+	"\xa3\xd9\x19\x98\xa3v\xb5\xa0\xb5\xbb\x81\xe6\xb3jH\x98" +
+	"\x09\x93\x905\x82\x19\x09M\xb9\xcc\x0bw\xf6\xaf\x1d\xce" +
+	"L\x0c4\xc7\xb4#[\xd6\x1a\xac\xbc\x1c\xad\x00\x86\x9d" +
+	"\xc4\x9c\x09Q\xe0ZQ&8a&8\xd1\x98V\xdb" +
+	"\x09\x1a\xcc\xc8d\xab\xaf9\x05\x01\x8a>$M&\xec" +
+	"@\x80\xe2N#6\x98\xf8\x86FL\xa7r\x02\x0d4" +
+	"b\xbe0\x95\xac\xc63.63z\x8aE\x16\xc6\x09" +
+	"\xad\xc7\x1d\xc1`5\x08\xc1\"\x7f\x05oNg\xcf4" +
+	"\xa8;DN^\xe2\xbaC\xc4\xac\xe4\x9e\xf5\x0b\xe5\x00" +
+	"\x917\x04\x11#\xef\x0b\xce\x95\x8b\x1d\x17\xe6\x03D\xde" +
+	"%\xfa\xdf\x05\xbba\xc1N\x08\xb3\x01\"\x7f!\xf2\xa7" +
+	"\xc4.\x0a\xfcN\xccN\x0a\xed\x00\x91\x8f\x89\x1e\x12\x05" +
+	"\x0c\xfaD\xde\xb6`Aq\x11@d\xa4(b\xe4\x12" +
+	"\xa2\x17\xf9x\x1f\x8a]$\xd2\xf0\x17\x12}&\xd1\x87" +
+	"`\x08\x87\x00\xb0&No$\xfa\x1c\xa2\xfb\x8bB\xc8" +
+	"\xa1pb3@d&\xd1\xdb\x88>\x14C8\x14\x80" +
+	"\xcd\x15w\x02D\xda\x88\xfe5\xa2\x17\x0f\x09a1\x00" +
+	"\xbb\x91\xf3_O\xf4$\xd1\x87\xf9C8\x0c\x80\xc5\xc5" +
+	"\x15\x00\x91\x18\xd1\xef \xfa9B\x08\xcf\x01`k9" +
+	"\xfdv\xa2\xdfE\xf4\xe1b\x08\x87\x03\xb0\x8db\x07@" +
+	"\xe4N\xa2\xdfO\xf4\x92\xa1!,\x01`\xf7\x88w\x03" +
+	"D\xee'\xfa#D\x1f!\x84p\x04\x00\xdb\xc6\xc7\xd9" +
+	"J\xf4]D\x0f\xf8B\x18\x00`;8};\xd1\x9f" +
+	"$\xfa\xc8\xe2\x10\x8e\x04`{\xb8~v\x13\xfd\x19\xa2" +
+	"\x07\x8bB\x18\x04`{\xf9\xbc?&\xfa\xf3D/\x15" +
+	"CX\x0a\xc0\xf6\x8b\xa4\xff\x9f\x12\xfdE\xa2\xb3a!" +
+	"d\x00\xec\x17\x9c\xfe\x02\xd1\x7fK\xf4\xd09!\x0c\x01" +
+	"\xb0\xc3\\\x9f\xbf&\xfa\x7f\x13\xbd\x0cCX\x06\xc0^" +
+	"\x155\x80\xc8\xef\x89\xfe6\xd1G\x0d\x0d\xe1(\x00v" +
+	"\x8c\xcb\xf9\x06\xd1\xdf'\xfa\xe8\xe1!\x1cM\xee\xc0\xe5" +
+	"y\x97\xe8>\x9f\x80\xc11%!\x1c\x03\xc0\xd0\xb7\x1e" +
+	" \xe2\xf3\x89\x18\x19I\xf4\xb1#B8\x16\x80\x95\xf8" +
+	"\x1e\"\xbb\x13}\x1c\xd1\xcb\x03!,\x07`c}\xb4" +
+	"\xde1D\x9f\xe4\xcb\xbe\xb5v\xa4\x12\x9d1\xb9M\x02" +
+	"\xd1U|5d-\xae$\xa4\x18\xdd\xa8\x11\x04\xc4\x0c" +
+	".\xa4M2\xba\x01\x07 @T5>\x8b\xbe\x85\x80" +
+	"dt\x0f\xf86fe\xdd\xa2\xe6\xaa\x9c\xbb\x90\xa4f" +
+	"\x89 \xa6\xear\xc4\xe8\x04QQ\xad9Wif!" +
+	"\xc0\x96\x8bd\xf8\xb2\x12\x93\xf1\x06M1\xe4v\xd9\x08" +
+	"h\x8a\xac[}\xabU\x9d<]sMb_E3" +
+	"\x93$%]7\xba5\x15\x1aR]\xdd\xb3\x12Ks" +
+	"EMj\xcaR\xc9\x90\xbf\x02\x0dr\x8f\xa6$\xba\xec" +
+	"\xc5gV8\x1b\xfc\xba\x9a\xb0\xa9\x9a\xaa\x1a\x0b\xf56" +
+	"\x0d\xd5\xa4\xd4%\x19\x8a\x8a\x09[\xd4\xcfl\xae\xc9\x09" +
+	"\xa9#&\xb7\xa8\xd0\xa0\xc93S\xf1\xa4=ZT5" +
+	"\x09\x10\xc8*\x84wKz\x9b\xa6\xa8\x1a\xf8\x15\xa3\xc7" +
+	"fN\x12I1z\xdc(\x9dnU7\x12R\x9c\xd7" +
+	"A\xac_w\xaaqII$$\x10]j\xb4\xcd[" +
+	"q\x83\xbbF\xe0\xd0\x1b\xae\xc9\xae\x16H\x89\x04]G" +
+	"2=L[\xbd\xf6\xbd?\xa3^\xcbE\xf0z9\x9e" +
+	"\x8cq \x8b=\xa7\xe5#t\xfd\xe0_\xfb%\xc3\x91" +
+	"HI(\x86\"\xc5\"\x100:\x95DA\xfd\xb1\x0c" +
+	"\x1a.?D\x83}\xff,\xa0X4/\xbb\xf6\xef\x0e" +
+	"\xf4\xae\x9et\xb9WO\xba\xd9\xa3'=\xdb\xd5~\xb6" +
+	"j\x9e\x1b\xdb\x9d\xf6\xb3\xdd\x93\xbe\xa7\xc3A-\xb8v" +
+	"\xf2\x80\xcd\x12M\xa6\xc2)\xd5\x90h\xa5v\x8f=\x99" +
+	"j\x935E\x05\xb4\x013\xe9\xb8\x1cW\xb5\x9e9\x0a" +
+	"\xf8\xe3\x8a1\xa0\x1b_\x90&\xceR/\"\xbf\"\xb7" +
+	"]\x15*\x00\x0a\xaa\xbb\xebq9\xcd\x10\x1d\xe0\xf3k" +
+	"\xfbvi\xa9\xa06\xf4\x80\x9eB~=W\xbb\x9eS" +
+	"\xc0\xe4\x1eHk\xa7\x9a\xed\x81\xabkt\xf9q=\xe9" +
+	"b\x9aY5\x0eH\x03\xa1\x81\x03]2\x8f\xfd\x9cI" +
+	"\xc9\x0bj\xca\xdb\x8at\xd7*\x1cp\xb2[\xab\xf33" +
+	"Z\xbdP\xc0t\xc2\xaaw\x88\xeeC\xc4\xf5\xc2%\xef" +
+	"bvt\xa0 \x83\x87\x9b\xdae\xc7B\xfaE\xd9Y" +
+	"h\x9e[\xe9L\x1al\xaen\xba\xe3Hg\xb7z\x9e" +
+	"\x83%\x1b\xfc.\xb5\xcb\x99\x05\xf4\xc1\xb8\x05\x03dB" +
+	"\x0e\xd9u\x1eAa\x15o}\xe5\x03B\xcd\xb4\xaf\xec" +
+	"12\xed\xab\x98\x92X\\\x90B<j\x96.$\x83" +
+	"\xab\x037\xdb\xe9\xb6Y\x82)u\xaef\x9b\xd5S\x88" +
+	"\xd79H\xe2\xa0x^\x06J<;\x03%\xbe\xb5\xa0" +
+	"7\x14\x14\x09:\xafK\x19\xee\xa42\x1f\x03\xf0+\\" +
+	"\xc5\xa5\xa4k\xbe*.\xe8E&<v\xa2\x09\x8f\x1d" +
+	"[\x09\x80b0\xe8F\xc9rg\x91;\xfd\xaa\x1a/" +
+	"\x04)\xdb\xe2B\xfaf\xe1{<\xf0\x0fY\xb1q\xbe" +
+	"\xd3<[\x15\x97\x8dn\xb5sP\x99qvS3\xdf" +
+	"x3\xe0\xf2\xe9\x9c\xc4^\xdd\xbe\xa9\x8e\x1bL\x99\xef" +
+	"\x82\x8c\xb9\x9a}m.hx\xb4KSS\xc9\x9c\xbb" +
+	"\xc2\x19\xc1\x8e\xf3\xeb\xfb\xdb\xfd\x94\x02\xc2\x92\x1d\x80\xc9" +
+	"\x81L\x10\xdd8\xbb}\x86\x18\x9cU\xc9=\xa8\xde\xf4" +
+	"\xa0Z\xfa\x9f/8\x99\xfeW\x14\xfcB\x15\x00\x0e\x09" +
+	"\x8e\xaf\x04h0u\xe0W\x92Q\x7f<a\xf8\x13\xb2" +
+	"\xe1O*\x9d\x81\x94.k\xfe\x94\xa1\x17\x84E\xc8\xd4" +
+	"\x88s\xf6j\xb9\x17\xec\x7f\xbe{\xb3fR\xc7\xb8\xe6" +
+	"t\xc6-\xd8\x7f\x8a\x92DC\xc4\xf0m\xd9aVW" +
+	"\xa3\x8be#\xc7\x84\xbc\xbe%\xeb:T(j\xa2\xd5" +
+	"a\xd6\xe4dL\xe2\x99a\xc2\xb9e\x9d\x09\xdcx\xc0" +
+	"S\x953\xc8\xcd\xf2K\xf4\xedf_!h\x95\x01\x1d" +
+	"\xe9A?O\xb2{k\x05x\xac\x95\x00\xe5wt\xdb" +
+	"}\xda3i\x7f\xbb\xd3\xa6\x8a\xff%\xa4a$\x1b\xb4" +
+	"\xf5Y\xa1\xaa\xce\x0b[V\xed\xc4\xaf\x86N\xfe\x8c\xc9" +
+	"\xeez\xc7\xe4\xa5r\xeclA\x1f\x07\x0d\xed\xb1{\xd0" +
+	"g\x02\xed\xb1\xde\x1d\x0c\xfa-\x86\xdd\xff- \x9d\xe1" +
+	"\xa7)\xe5L\xe8~vn\xe52\xdea\xe8_\x83v" +
+	"\xda\xdd)\x03\x0eL\x19\xb2\x00\xe1f\x02\xe4\xc6\x89\x04" +
+	"\\\x10\x1d\xc0\xa4}\x11\xf5z\x8fT\x10\xb2\xdd\xdb\xbf" +
+	"\xbcR\xb5v\x07\xba\x98\x8d\xd75\xb4T\"*\x192" +
+	"]\x9fsS\x98B \x9f\xf9mm\x1b\x18P@0" +
+	"q\xbd\xf7\xb3\x8e?\x1f7[Q\x9f\x19\x8e3\xcd\xdd" +
+	"L\xa0kM\x18\xb2\xb6P\x8a\xa2\x9c\xd7,Y\x00\xde" +
+	"\xcc\xb3\xa8\xcfwc\x1b.P\xc0\xba,X\x80\xdb\xba" +
+	"\xae\x07\x1e\xcd^\x0f<*\xbd\x1exP\x9cyP\xc4" +
+	"\xf0v\xd7\x03\x8f^r\x84GD\x0c\xefv=\xf0x" +
+	"\xbc\xc3y\xe0\x81\xd6\xfb\x8ev\xe7}\x87\xd5K\xb2\x9f" +
+	"}\x1aR\x97\xf5w\x03\xadG1\\\xc5W%\xd69" +
+	"S2\x00\x9d;\xb2\x96\xd2\x0dZ\x15\xf8]\x83X/" +
+	"\xf0Z\xc9\xf3\x0a9\x88=Q\x0d\xd6\xed\xecr\xbb/" +
+	"\xd1\x84\xe5\x00\x91\x19(b\xe4\x1a\xb4w\x04\x9b\x85\xf3" +
+	"\x01\"3\x89\xdc\x86\xce\x9egs\xb1\x19 r\x0d\xd1" +
+	";\x89.6\x9a}\x09\x09g\x03Dn!z\x0c\x9d" +
+	"\xd2\x15S8\x7f'\xd1\x93D/\x12\xcd\xbeD\x1c\x17" +
+	"\x01DbD_N\xf4!\xa2\xd9\x97Ha%@$" +
+	"I\xf4[\x89\xee\xf7\x99}\x89\x1e\xac\x02\x88\x18D\xbf" +
+	"\x8d\xe8C\x9b\xcc\xbe\xc4J\xd4\x00\"\xb7\x12\xfd\x0e\xa2" +
+	"\x177\x87\xb0\x18\x91\xad\xc5\x9d\x00\x91;\x88\xfem\xa2" +
+	"\x0f+2\xfb\x12\x9b\xb8<w\x12};\xd1\xcf\x19b" +
+	"\xf6%z\xb9<\x8f\x10\xfd\xa7\x98}\xc4\xd1\xeePS" +
+	"F\x04D9j\xbf\xc6\x89\x9ayAn~\xefQF" +
+	"\x1fX\x12\x91\x12Q9\x16Q \xd0\x95\x90bV\x84" +
+	"\xf3G\x979\xc1\x92rK\xa7R\xaa\xab1J\xf1\xa1" +
+	"\x82'\xa2v\x18R\x12\xdd\xb2\xa6\x18_FM\x8ds" +
+	"#\x07\xc8\xca\xf4u\x919\xafY\x1fu\xca\x1a6\xdc" +
+	"\xca\xbafd\xb5\xdf>\xeb\x91g!\xb5\x87AC\x8a" +
+	"\x07\x80\xc3\xcf\xe8\x09]\x06\x9b\x92\x93\xb94;X\xfe" +
+	"\x81\xfd\x02\x1b4u\x96J=\xf9\xbdg\xb6\xa1d\x05" +
+	",\x7f\xe0\x13\xfa\xfcP\x936\xdc\xae\x10(z6\x98" +
+	"\xd1\x1a6\xdf\x16\xad\x05\xdc\xe2\xb8-\xbf\xa1\xf5\xe4\x9c" +
+	"\xd4\x95\x9f\x93\x09\xfa\x17\xcb=v\x1a\xb8T\x8a\xa5\xe4" +
+	"\x82\xd2\xc0\xac\xa7\xbd\xf9=B\xb1\xb1mg\xe1%\xc1" +
+	"\xa07\x8d\x0d\x9a+`R\xcf\x7f\x01\x01\xcd\x02\x97\xfd" +
+	"\xcf9\x06\xb1\xddFW\x01j\x9fU<\xb5k\x06Y" +
+	"m>{\x94\x02v\x94\xe7cq\x8f\xac\xa2\xda\xd1\x8b" +
+	"\xd9\xb4\xc7\x91\xee\x7f<)\x7f\x7f\xcey\xd2\xe6\xba\x9e" +
+	"\xbb\xdc\xb1\xdc\xcb\x1d\x9b\x1dw\xfc\x17\xad\x99\xff\x1f\x00" +
+	"\x00\xff\xff\x15W\xb0B"
+>>>>>>> acf1cda ([containers/conmon-rs#synth-773] fix: filter the container log on the server)
 
 func init() {
 	schemas.Register(schema_ffaaf7385bc4adad,
+		0x81a239eedba512c8,
+		0x82bfbf84bbdf2850,
 		0x83479da67279e173,
+		0x8aef91973dc8a4f5,
+		0x8b4c03a0662a38dc,
+		0x90a3950a51412b8b,
+		0x9b0d278358e9d418,
 		0xa0ef8355b64ee985,
 		0xa20f49456be85b99,
 		0xaa2f3c8ad1c3af24,
@@ -2298,18 +5709,26 @@ func init() {
 		0xc5e65eec3dcf5b10,
 		0xc76ccd4502bb61e7,
 		0xcc2f70676afee4e7,
+		0xcdd84e02c7acf641,
+		0xce733f0914c80b6b,
 		0xceba3c1a97be15f8,
 		0xd0476e0f34d1411a,
+		0xd61491b560a8f3a3,
 		0xd9d61d1d803c85fc,
 		0xde3a625e70772b9a,
+		0xdebaeed2a782ac80,
+		0xdedbd323fc140cfd,
 		0xdf703ca0befc3afc,
 		0xe00e522611477055,
 		0xe313695ea9477b30,
+		0xe32c2c8bfd0773d0,
 		0xe5ea916eb0c31336,
+		0xebbc7ae7ae262bb9,
 		0xf026e3d750335bc1,
 		0xf34be5cbac1feed1,
 		0xf41122f890a371a6,
 		0xf44732c48f949ab8,
+		0xf4e3e92ae0815f15,
 		0xf8e86a5c0baa01bc,
 		0xf9b3cd8033aba1f8)
 }
@@ -122,7 +122,7 @@ func (c *ConmonClient) AttachContainer(ctx context.Context, cfg *AttachConfig) e
 
 	result, err := future.Struct()
 	if err != nil {
-		return fmt.Errorf("create result: %w", err)
+		return fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	if _, err := result.Response(); err != nil {
@@ -367,7 +367,7 @@ func (c *ConmonClient) SetWindowSizeContainer(ctx context.Context, cfg *SetWindo
 
 	result, err := future.Struct()
 	if err != nil {
-		return fmt.Errorf("create result: %w", err)
+		return fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	if _, err := result.Response(); err != nil {
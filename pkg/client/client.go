@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
@@ -32,6 +33,7 @@ var (
 	errInvalidValue       = errors.New("invalid value")
 	errRunDirNotCreated   = errors.New("could not create RunDir")
 	errTimeoutWaitForPid  = errors.New("timed out waiting for server PID to disappear")
+	errCloseStdioTerminal = errors.New("CloseStdio cannot be used together with Terminal")
 )
 
 // ConmonClient is the main client structure of this package.
@@ -275,7 +277,7 @@ func (c *ConmonClient) waitUntilServerUp() (err error) {
 	for i := 0; i < 100; i++ {
 		ctx, cancel := defaultContext()
 
-		_, err = c.Version(ctx)
+		err = c.HealthCheck(ctx)
 		if err == nil {
 			cancel()
 
@@ -296,7 +298,7 @@ func defaultContext() (context.Context, context.CancelFunc) {
 func (c *ConmonClient) newRPCConn() (*rpc.Conn, error) {
 	socketConn, err := DialLongSocket("unix", c.socket())
 	if err != nil {
-		return nil, fmt.Errorf("dial long socket: %w", err)
+		return nil, fmt.Errorf("dial long socket: %w", newServerUnavailableError(err))
 	}
 
 	return rpc.NewConn(rpc.NewStreamTransport(socketConn), nil), nil
@@ -366,7 +368,7 @@ func (c *ConmonClient) Version(ctx context.Context) (*VersionResponse, error) {
 
 	result, err := future.Struct()
 	if err != nil {
-		return nil, fmt.Errorf("create result: %w", err)
+		return nil, fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	response, err := result.Response()
@@ -409,6 +411,27 @@ func (c *ConmonClient) Version(ctx context.Context) (*VersionResponse, error) {
 	}, nil
 }
 
+// HealthCheck can be used to verify that the server is responsive. It does
+// not evaluate any of the returned data and is therefore cheaper than calling
+// Version.
+func (c *ConmonClient) HealthCheck(ctx context.Context) error {
+	conn, err := c.newRPCConn()
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	future, free := client.Version(ctx, nil)
+	defer free()
+
+	if _, err := future.Struct(); err != nil {
+		return fmt.Errorf("create result: %w", mapRPCError(err))
+	}
+
+	return nil
+}
+
 // CreateContainerConfig is the configuration for calling the CreateContainer
 // method.
 type CreateContainerConfig struct {
@@ -429,6 +452,22 @@ type CreateContainerConfig struct {
 
 	// LogDrivers is a slice of selected log drivers.
 	LogDrivers []LogDriver
+
+	// CloseStdio indicates that the standard input of the container should be
+	// connected to /dev/null rather than a pipe, which means that it cannot
+	// be attached to. The standard output and error streams are still
+	// forwarded to the log drivers. Cannot be used together with Terminal.
+	CloseStdio bool
+
+	// Runtime is the binary path of the OCI runtime to be used for this
+	// container. The server default runtime is used if empty.
+	Runtime string
+
+	// ExitFileWriteRetries is the number of retries for writing the exit
+	// files if the initial write fails, for example because of a temporarily
+	// unavailable filesystem. The delay between the attempts starts at 100ms
+	// and gets doubled on every retry. 0 disables retries.
+	ExitFileWriteRetries uint32
 }
 
 // LogDriver specifies a selected logging mechanism.
@@ -470,7 +509,78 @@ func (c *ConmonClient) CreateContainer(
 	defer conn.Close()
 	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
 
-	future, free := client.CreateContainer(ctx, func(p proto.Conmon_createContainer_Params) error {
+	return c.createContainer(ctx, client, cfg)
+}
+
+// CreateContainers can be used to create multiple containers, for example all
+// containers of a pod. All requests are sent over a single connection before
+// waiting for any of the responses, which lets the server process them in one
+// round trip. The returned responses are in the same order as the provided
+// configs. If any of the creations fail, then the corresponding response will
+// be nil and a *BatchError gets returned, which contains the errors for each
+// failed index.
+func (c *ConmonClient) CreateContainers(
+	ctx context.Context, cfgs []*CreateContainerConfig,
+) ([]*CreateContainerResponse, error) {
+	conn, err := c.newRPCConn()
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	responses := make([]*CreateContainerResponse, len(cfgs))
+	errs := make([]error, len(cfgs))
+	futures := make([]proto.Conmon_createContainer_Results_Future, len(cfgs))
+	frees := make([]capnp.ReleaseFunc, len(cfgs))
+	for i, cfg := range cfgs {
+		if cfg == nil {
+			errs[i] = fmt.Errorf("%w: config must not be nil", errInvalidValue)
+
+			continue
+		}
+		if cfg.CloseStdio && cfg.Terminal {
+			errs[i] = errCloseStdioTerminal
+
+			continue
+		}
+
+		futures[i], frees[i] = c.sendCreateContainer(ctx, client, cfg)
+	}
+
+	for i, free := range frees {
+		if free != nil {
+			responses[i], errs[i] = createContainerResponse(futures[i])
+			free()
+		}
+	}
+
+	if err := newBatchError(errs); err != nil {
+		return responses, err
+	}
+
+	return responses, nil
+}
+
+func (c *ConmonClient) createContainer(
+	ctx context.Context, client proto.Conmon, cfg *CreateContainerConfig,
+) (*CreateContainerResponse, error) {
+	if cfg.CloseStdio && cfg.Terminal {
+		return nil, errCloseStdioTerminal
+	}
+
+	future, free := c.sendCreateContainer(ctx, client, cfg)
+	defer free()
+
+	return createContainerResponse(future)
+}
+
+// sendCreateContainer sends the create container request without waiting for
+// its response.
+func (c *ConmonClient) sendCreateContainer(
+	ctx context.Context, client proto.Conmon, cfg *CreateContainerConfig,
+) (proto.Conmon_createContainer_Results_Future, capnp.ReleaseFunc) {
+	return client.CreateContainer(ctx, func(p proto.Conmon_createContainer_Params) error {
 		req, err := p.NewRequest()
 		if err != nil {
 			return fmt.Errorf("create request: %w", err)
@@ -482,6 +592,11 @@ func (c *ConmonClient) CreateContainer(
 			return fmt.Errorf("set bundle path: %w", err)
 		}
 		req.SetTerminal(cfg.Terminal)
+		req.SetCloseStdio(cfg.CloseStdio)
+		if err := req.SetRuntime(cfg.Runtime); err != nil {
+			return fmt.Errorf("set runtime: %w", err)
+		}
+		req.SetExitFileWriteRetries(cfg.ExitFileWriteRetries)
 		if err := stringSliceToTextList(cfg.ExitPaths, req.NewExitPaths); err != nil {
 			return fmt.Errorf("convert exit paths string slice to text list: %w", err)
 		}
@@ -502,11 +617,16 @@ func (c *ConmonClient) CreateContainer(
 
 		return nil
 	})
-	defer free()
+}
 
+// createContainerResponse waits for the response of a sent create container
+// request.
+func createContainerResponse(
+	future proto.Conmon_createContainer_Results_Future,
+) (*CreateContainerResponse, error) {
 	result, err := future.Struct()
 	if err != nil {
-		return nil, fmt.Errorf("create result: %w", err)
+		return nil, fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	response, err := result.Response()
@@ -533,6 +653,10 @@ type ExecSyncConfig struct {
 
 	// Terminal specifies if a tty should be used.
 	Terminal bool
+
+	// Runtime is the binary path of the OCI runtime to be used for the
+	// execution. The server default runtime is used if empty.
+	Runtime string
 }
 
 // ExecContainerResult is the result for calling the ExecSyncContainer method.
@@ -560,6 +684,46 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 	defer conn.Close()
 
 	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	return execSyncContainer(ctx, client, cfg)
+}
+
+// ExecSyncMany can be used to execute commands within multiple running
+// containers. All requests are sent concurrently over a single connection.
+// The returned results are in the same order as the provided configs. If any
+// of the executions fail, then the corresponding result will be nil and a
+// *BatchError gets returned, which contains the errors for each failed
+// index.
+func (c *ConmonClient) ExecSyncMany(ctx context.Context, cfgs []*ExecSyncConfig) ([]*ExecContainerResult, error) {
+	conn, err := c.newRPCConn()
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	results := make([]*ExecContainerResult, len(cfgs))
+	errs := make([]error, len(cfgs))
+
+	var wg sync.WaitGroup
+	for i, cfg := range cfgs {
+		wg.Add(1)
+		go func(i int, cfg *ExecSyncConfig) {
+			defer wg.Done()
+			results[i], errs[i] = execSyncContainer(ctx, client, cfg)
+		}(i, cfg)
+	}
+	wg.Wait()
+
+	if err := newBatchError(errs); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
+
+func execSyncContainer(ctx context.Context, client proto.Conmon, cfg *ExecSyncConfig) (*ExecContainerResult, error) {
 	future, free := client.ExecSyncContainer(ctx, func(p proto.Conmon_execSyncContainer_Params) error {
 		req, err := p.NewRequest()
 		if err != nil {
@@ -573,6 +737,9 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 			return err
 		}
 		req.SetTerminal(cfg.Terminal)
+		if err := req.SetRuntime(cfg.Runtime); err != nil {
+			return fmt.Errorf("set runtime: %w", err)
+		}
 		if err := p.SetRequest(req); err != nil {
 			return fmt.Errorf("set request: %w", err)
 		}
@@ -583,7 +750,7 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 
 	result, err := future.Struct()
 	if err != nil {
-		return nil, fmt.Errorf("create result: %w", err)
+		return nil, fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	resp, err := result.Response()
@@ -721,7 +888,7 @@ func (c *ConmonClient) ReopenLogContainer(ctx context.Context, cfg *ReopenLogCon
 
 	result, err := future.Struct()
 	if err != nil {
-		return fmt.Errorf("create result: %w", err)
+		return fmt.Errorf("create result: %w", mapRPCError(err))
 	}
 
 	if _, err := result.Response(); err != nil {
@@ -730,3 +897,184 @@ func (c *ConmonClient) ReopenLogContainer(ctx context.Context, cfg *ReopenLogCon
 
 	return nil
 }
+
+// SwapLogPathConfig is the configuration for calling the SwapLogPath method.
+type SwapLogPathConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// Path is the current path of the log driver to be switched.
+	Path string
+
+	// NewPath is the path the log driver should write to from now on.
+	NewPath string
+}
+
+// SwapLogPath can be used to switch the target path of a container log
+// driver. All buffered log data is written to the old path before the new
+// one gets used, which means that external log rotation can move the old
+// file away without losing any lines.
+func (c *ConmonClient) SwapLogPath(ctx context.Context, cfg *SwapLogPathConfig) error {
+	conn, err := c.newRPCConn()
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	future, free := client.SwapLogPath(ctx, func(p proto.Conmon_swapLogPath_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(cfg.ID); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetPath(cfg.Path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
+		if err := req.SetNewPath(cfg.NewPath); err != nil {
+			return fmt.Errorf("set new path: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return fmt.Errorf("create result: %w", mapRPCError(err))
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
+
+// ContainerNamespacesConfig is the configuration for calling the
+// ContainerNamespaces method.
+type ContainerNamespacesConfig struct {
+	// ID is the container identifier.
+	ID string
+}
+
+// Namespace specifies a Linux namespace type.
+type Namespace int
+
+const (
+	// NamespaceCgroup is the cgroup namespace.
+	NamespaceCgroup Namespace = iota
+
+	// NamespaceIPC is the IPC namespace.
+	NamespaceIPC
+
+	// NamespaceMount is the mount namespace.
+	NamespaceMount
+
+	// NamespaceNet is the network namespace.
+	NamespaceNet
+
+	// NamespacePID is the PID namespace.
+	NamespacePID
+
+	// NamespaceUser is the user namespace.
+	NamespaceUser
+
+	// NamespaceUTS is the UTS namespace.
+	NamespaceUTS
+)
+
+// ContainerNamespaces can be used to retrieve the namespaces the init process
+// of a running container is attached to. The values of the returned map are
+// the targets of the /proc/<pid>/ns/* links of the process, for example
+// "net:[4026531840]". Namespaces which are not supported by the kernel are
+// not part of the result.
+func (c *ConmonClient) ContainerNamespaces(
+	ctx context.Context, cfg *ContainerNamespacesConfig,
+) (map[Namespace]string, error) {
+	conn, err := c.newRPCConn()
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	future, free := client.ContainerNamespaces(ctx, func(p proto.Conmon_containerNamespaces_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(cfg.ID); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", mapRPCError(err))
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	namespaces, err := response.Namespaces()
+	if err != nil {
+		return nil, fmt.Errorf("get namespaces: %w", err)
+	}
+
+	res := make(map[Namespace]string, namespaces.Len())
+	for i := 0; i < namespaces.Len(); i++ {
+		namespace := namespaces.At(i)
+
+		link, err := namespace.Link()
+		if err != nil {
+			return nil, fmt.Errorf("get namespace link: %w", err)
+		}
+
+		if typ, ok := namespaceFromProto(namespace.Type()); ok {
+			res[typ] = link
+		}
+	}
+
+	return res, nil
+}
+
+func namespaceFromProto(typ proto.Conmon_Namespace_Type) (Namespace, bool) {
+	switch typ {
+	case proto.Conmon_Namespace_Type_cgroup:
+		return NamespaceCgroup, true
+	case proto.Conmon_Namespace_Type_ipc:
+		return NamespaceIPC, true
+	case proto.Conmon_Namespace_Type_mnt:
+		return NamespaceMount, true
+	case proto.Conmon_Namespace_Type_net:
+		return NamespaceNet, true
+	case proto.Conmon_Namespace_Type_pid:
+		return NamespacePID, true
+	case proto.Conmon_Namespace_Type_user:
+		return NamespaceUser, true
+	case proto.Conmon_Namespace_Type_uts:
+		return NamespaceUTS, true
+	}
+
+	return 0, false
+}
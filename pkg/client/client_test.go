@@ -2,6 +2,7 @@ package client_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -49,6 +50,50 @@ var _ = Describe("ConmonClient", func() {
 		})
 	})
 
+	Describe("HealthCheck", func() {
+		It("should succeed for a running server", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			Expect(sut.HealthCheck(context.Background())).To(BeNil())
+		})
+
+		It("should fail if the server is not running", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			Expect(sut.Shutdown()).To(BeNil())
+			Expect(sut.HealthCheck(context.Background())).NotTo(BeNil())
+			sut = nil
+		})
+	})
+
+	Describe("Errors", func() {
+		It("should return container not found", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			err := sut.ReopenLogContainer(context.Background(), &client.ReopenLogContainerConfig{
+				ID: "does-not-exist",
+			})
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+		})
+
+		It("should return server unavailable", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			Expect(sut.Shutdown()).To(BeNil())
+
+			_, err := sut.Version(context.Background())
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, client.ErrServerUnavailable)).To(BeTrue())
+			sut = nil
+		})
+	})
+
 	Describe("CreateContainer", func() {
 		for _, terminal := range []bool{true, false} {
 			terminal := terminal
@@ -68,6 +113,33 @@ var _ = Describe("ConmonClient", func() {
 				Expect(fileContents(tr.exitPath())).To(Equal("0"))
 			})
 
+			It(testName("should retry writing the exit file", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfig(terminal)
+				sut = tr.configGivenEnv()
+				cfg := tr.defaultConfig(terminal)
+				exitDir := filepath.Join(tr.tmpDir, "exit-dir")
+				exitPath := filepath.Join(exitDir, "exit")
+				cfg.ExitPaths = []string{exitPath}
+				cfg.ExitFileWriteRetries = 5
+				tr.createContainerWithConfig(sut, cfg)
+
+				// The exit directory is not available when the container exits
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(500 * time.Millisecond)
+					Expect(os.MkdirAll(exitDir, 0o755)).To(BeNil())
+				}()
+				tr.startContainer(sut)
+
+				Eventually(func() error {
+					_, err := os.Stat(exitPath)
+
+					return err
+				}, time.Second*10).Should(BeNil())
+				Expect(fileContents(exitPath)).To(Equal("0"))
+			})
+
 			It(testName("should kill created children if being killed", terminal), func() {
 				tr = newTestRunner()
 				tr.createRuntimeConfig(terminal)
@@ -97,6 +169,26 @@ var _ = Describe("ConmonClient", func() {
 				})
 				Expect(err).NotTo(BeNil())
 				Expect(err.Error()).To(ContainSubstring(`executable file not found in $PATH"`))
+				Expect(errors.Is(err, client.ErrRuntimeFailure)).To(BeTrue())
+			})
+
+			It(testName("should use the provided runtime", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfig(terminal)
+				sut = tr.configGivenEnv()
+				cfg := tr.defaultConfig(terminal)
+				cfg.Runtime = runtimePath
+				tr.createContainerWithConfig(sut, cfg)
+			})
+
+			It(testName("should fail with an invalid runtime", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfig(terminal)
+				sut = tr.configGivenEnv()
+				cfg := tr.defaultConfig(terminal)
+				cfg.Runtime = filepath.Join(tr.tmpDir, "does-not-exist")
+				_, err := sut.CreateContainer(context.Background(), cfg)
+				Expect(err).NotTo(BeNil())
 			})
 
 			It(testName("should handle long run dir", terminal), func() {
@@ -179,6 +271,244 @@ var _ = Describe("ConmonClient", func() {
 		}
 	})
 
+	Describe("CreateContainers", func() {
+		It("should create multiple containers and report failures", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			runner := &testRunner{tmpDir: MustDirInTempDir(tr.tmpDir, "ctr")}
+			runner.createRuntimeConfig(false)
+			runner.rr = tr.rr
+			defer func() {
+				Expect(runner.rr.RunCommand("delete", "-f", runner.ctrID)).To(BeNil())
+			}()
+
+			invalidCfg := runner.defaultConfig(false)
+			invalidCfg.ID = ""
+			invalidCfg.BundlePath = filepath.Join(tr.tmpDir, "does-not-exist")
+
+			responses, err := sut.CreateContainers(context.Background(), []*client.CreateContainerConfig{
+				tr.defaultConfig(false),
+				invalidCfg,
+				nil,
+				runner.defaultConfig(false),
+			})
+			Expect(err).NotTo(BeNil())
+
+			var batchErr *client.BatchError
+			Expect(errors.As(err, &batchErr)).To(BeTrue())
+			Expect(batchErr.Errors).To(HaveLen(2))
+			Expect(batchErr.Errors).To(HaveKey(1))
+			Expect(batchErr.Errors).To(HaveKey(2))
+
+			Expect(responses).To(HaveLen(4))
+			Expect(responses[0]).NotTo(BeNil())
+			Expect(responses[1]).To(BeNil())
+			Expect(responses[2]).To(BeNil())
+			Expect(responses[3]).NotTo(BeNil())
+		})
+	})
+
+	Describe("CloseStdio", func() {
+		It("should connect stdin to /dev/null", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "readlink", "/proc/self/fd/0"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			cfg := tr.defaultConfig(false)
+			cfg.CloseStdio = true
+			tr.createContainerWithConfig(sut, cfg)
+			tr.startContainer(sut)
+
+			Expect(fileContents(tr.logPath())).To(ContainSubstring("/dev/null"))
+		})
+
+		It("should use a pipe for stdin per default", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "readlink", "/proc/self/fd/0"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(fileContents(tr.logPath())).To(ContainSubstring("pipe:"))
+		})
+
+		It("should fail together with terminal", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(true)
+			sut = tr.configGivenEnv()
+			cfg := tr.defaultConfig(true)
+			cfg.CloseStdio = true
+			_, err := sut.CreateContainer(context.Background(), cfg)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("ContainerNamespaces", func() {
+		It("should return the namespaces of the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "10"}, nil)
+			sut = tr.configGivenEnv()
+			resp, err := sut.CreateContainer(context.Background(), tr.defaultConfig(false))
+			Expect(err).To(BeNil())
+
+			namespaces, err := sut.ContainerNamespaces(context.Background(), &client.ContainerNamespacesConfig{
+				ID: tr.ctrID,
+			})
+			Expect(err).To(BeNil())
+
+			netns, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/net", resp.PID))
+			Expect(err).To(BeNil())
+			Expect(namespaces).To(HaveKeyWithValue(client.NamespaceNet, netns))
+			Expect(namespaces[client.NamespacePID]).To(HavePrefix("pid:"))
+		})
+
+		It("should fail for an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.ContainerNamespaces(context.Background(), &client.ContainerNamespacesConfig{
+				ID: "does-not-exist",
+			})
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("SwapLogPath", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should write subsequent lines to the new path", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(
+					terminal,
+					[]string{"/busybox", "sh", "-c", "echo before && sleep 3 && echo after"},
+					nil,
+				)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				Eventually(func() string {
+					return fileContents(tr.logPath())
+				}, time.Second*3).Should(ContainSubstring("before"))
+
+				newLogPath := filepath.Join(tr.tmpDir, "new-log")
+				Expect(sut.SwapLogPath(context.Background(), &client.SwapLogPathConfig{
+					ID:      tr.ctrID,
+					Path:    tr.logPath(),
+					NewPath: newLogPath,
+				})).To(BeNil())
+
+				Eventually(func() string {
+					return fileContents(newLogPath)
+				}, time.Second*10).Should(ContainSubstring("after"))
+				Expect(fileContents(newLogPath)).NotTo(ContainSubstring("before"))
+
+				logs := fileContents(tr.logPath())
+				Expect(logs).To(ContainSubstring("before"))
+				Expect(logs).NotTo(ContainSubstring("after"))
+			})
+
+			It(testName("should fail if no log driver matches the path", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+
+				err := sut.SwapLogPath(context.Background(), &client.SwapLogPathConfig{
+					ID:      tr.ctrID,
+					Path:    filepath.Join(tr.tmpDir, "wrong"),
+					NewPath: filepath.Join(tr.tmpDir, "new-log"),
+				})
+				Expect(err).NotTo(BeNil())
+			})
+		}
+	})
+
+	Describe("ReadContainerLog", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should read the container log", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(
+					terminal,
+					[]string{"/busybox", "sh", "-c", "echo hello && echo world"},
+					nil,
+				)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				Eventually(func() string {
+					return fileContents(tr.exitPath())
+				}, time.Second*5).Should(Equal("0"))
+
+				logs, err := sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+					ID: tr.ctrID,
+				})
+				Expect(err).To(BeNil())
+				Expect(string(logs)).To(MatchRegexp("^hello\\r?\nworld\\r?\n$"))
+
+				logs, err = sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+					ID:   tr.ctrID,
+					Tail: 1,
+				})
+				Expect(err).To(BeNil())
+				Expect(string(logs)).NotTo(ContainSubstring("hello"))
+				Expect(string(logs)).To(ContainSubstring("world"))
+
+				logs, err = sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+					ID:  tr.ctrID,
+					Raw: true,
+				})
+				Expect(err).To(BeNil())
+				Expect(string(logs)).To(ContainSubstring(" stdout F hello"))
+
+				logs, err = sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+					ID:    tr.ctrID,
+					Since: time.Now(),
+				})
+				Expect(err).To(BeNil())
+				Expect(logs).To(BeEmpty())
+			})
+		}
+
+		It("should count partial records as a single line", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false,
+				[]string{"/busybox", "sh", "-c", "echo first && printf hel && sleep 1 && echo lo"},
+				nil,
+			)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(tr.exitPath())
+			}, time.Second*5).Should(Equal("0"))
+
+			logs, err := sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+				ID:  tr.ctrID,
+				Raw: true,
+			})
+			Expect(err).To(BeNil())
+			Expect(string(logs)).To(ContainSubstring(" stdout P hel"))
+
+			logs, err = sut.ReadContainerLog(context.Background(), &client.ReadLogConfig{
+				ID:   tr.ctrID,
+				Tail: 1,
+			})
+			Expect(err).To(BeNil())
+			Expect(string(logs)).To(Equal("hello\n"))
+		})
+	})
+
 	Describe("ExecSync Stress", func() {
 		for _, terminal := range []bool{true, false} {
 			terminal := terminal
@@ -212,6 +542,51 @@ var _ = Describe("ConmonClient", func() {
 		}
 	})
 
+	Describe("ExecSyncMany", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should execute in multiple containers", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				ctrIDs := []string{tr.ctrID}
+				for i := 0; i < 2; i++ {
+					runner := &testRunner{tmpDir: MustDirInTempDir(tr.tmpDir, fmt.Sprintf("ctr%d", i))}
+					runner.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+					runner.rr = tr.rr
+					runner.createContainer(sut, terminal)
+					runner.startContainer(sut)
+					defer func() {
+						Expect(runner.rr.RunCommand("delete", "-f", runner.ctrID)).To(BeNil())
+					}()
+					ctrIDs = append(ctrIDs, runner.ctrID)
+				}
+
+				cfgs := []*client.ExecSyncConfig{}
+				for i, ctrID := range ctrIDs {
+					cfgs = append(cfgs, &client.ExecSyncConfig{
+						ID:       ctrID,
+						Command:  []string{"/busybox", "echo", "-n", "hello", fmt.Sprintf("%d", i)},
+						Timeout:  timeoutUnlimited,
+						Terminal: terminal,
+					})
+				}
+
+				results, err := sut.ExecSyncMany(context.Background(), cfgs)
+				Expect(err).To(BeNil())
+				Expect(results).To(HaveLen(3))
+				for i, result := range results {
+					Expect(result).NotTo(BeNil())
+					Expect(result.ExitCode).To(BeEquivalentTo(0))
+					Expect(string(result.Stdout)).To(Equal(fmt.Sprintf("hello %d", i)))
+				}
+			})
+		}
+	})
+
 	Describe("ExecSyncContainer", func() {
 		for _, terminal := range []bool{true, false} {
 			terminal := terminal
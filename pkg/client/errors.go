@@ -0,0 +1,121 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+var (
+	// ErrContainerNotFound is returned if the server does not know about the
+	// requested container.
+	ErrContainerNotFound = errors.New("container not found")
+
+	// ErrServerUnavailable is returned if the server cannot be reached, for
+	// example because it is not running or the connection got lost.
+	ErrServerUnavailable = errors.New("server unavailable")
+
+	// ErrRuntimeFailure is returned if the OCI runtime failed to execute the
+	// requested operation.
+	ErrRuntimeFailure = errors.New("runtime failure")
+)
+
+const (
+	// serverErrContainerNotFound is the message of the server if a container
+	// is not being tracked.
+	serverErrContainerNotFound = "child not available"
+
+	// serverErrRuntimeFailure is the message of the server if the OCI
+	// runtime returned a non-zero exit code.
+	serverErrRuntimeFailure = "child command exited with"
+)
+
+// rpcError annotates an error with one of the exported sentinel errors while
+// keeping the original error chain intact.
+type rpcError struct {
+	sentinel error
+	err      error
+}
+
+func (e *rpcError) Error() string {
+	return e.err.Error()
+}
+
+func (e *rpcError) Unwrap() error {
+	return e.err
+}
+
+func (e *rpcError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+// newServerUnavailableError annotates the provided error with
+// ErrServerUnavailable.
+func newServerUnavailableError(err error) error {
+	return &rpcError{sentinel: ErrServerUnavailable, err: err}
+}
+
+// mapRPCError converts an error returned by an RPC call into one which can be
+// matched against the exported sentinel errors by using errors.Is.
+func mapRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+
+	switch {
+	case capnp.IsDisconnected(err):
+		return newServerUnavailableError(err)
+
+	case strings.Contains(msg, serverErrContainerNotFound):
+		return &rpcError{sentinel: ErrContainerNotFound, err: err}
+
+	case strings.Contains(msg, serverErrRuntimeFailure):
+		return &rpcError{sentinel: ErrRuntimeFailure, err: err}
+	}
+
+	return err
+}
+
+// BatchError is returned by methods operating on multiple items at once if at
+// least one of the items failed.
+type BatchError struct {
+	// Errors maps the index of every failed item to its error.
+	Errors map[int]error
+}
+
+// newBatchError creates a new *BatchError if any of the provided errors is
+// not nil.
+func newBatchError(errs []error) error {
+	batchErr := &BatchError{Errors: make(map[int]error)}
+	for i, err := range errs {
+		if err != nil {
+			batchErr.Errors[i] = err
+		}
+	}
+
+	if len(batchErr.Errors) == 0 {
+		return nil
+	}
+
+	return batchErr
+}
+
+func (e *BatchError) Error() string {
+	indices := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+
+	msgs := make([]string, 0, len(indices))
+	for _, i := range indices {
+		msgs = append(msgs, fmt.Sprintf("index %d: %v", i, e.Errors[i]))
+	}
+
+	return fmt.Sprintf("%d of the batch operations failed: %s", len(indices), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+const (
+	criLogPartsCount = 4
+	criLogTagFull    = "F"
+	criLogPartsSep   = " "
+	criLogLineSep    = '\n'
+	criLogTagIx      = 2
+	criLogContentIx  = 3
+)
+
+var errInvalidCRILogLine = errors.New("invalid CRI log line")
+
+// ReadLogConfig is the configuration for calling the ReadContainerLog method.
+type ReadLogConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// Tail is the number of log lines to be returned from the end of the
+	// log. 0 returns all lines. Partial CRI records are combined with their
+	// following records up to the full one, which makes them a single line.
+	Tail uint64
+
+	// Since can be used to return only the log lines written after the
+	// provided time. The zero value returns all lines.
+	Since time.Time
+
+	// Raw indicates that the lines should be returned as they are written to
+	// the CRI log file, including the timestamp, stream and tag prefixes.
+	Raw bool
+}
+
+// ReadContainerLog can be used to read back the output of a container from
+// its first log driver. The log is available as long as the server tracks
+<<<<<<< HEAD
+// the container, which means also after it exited.
+func (c *ConmonClient) ReadContainerLog(ctx context.Context, cfg *ReadLogConfig) ([]byte, error) {
+	conn, err := c.newRPCConn()
+=======
+// the container, which means also after it exited. Only the current log file
+// is read, rotated log files are not considered. The server filters the log
+// and omits the oldest lines if the result would exceed half of its maximum
+// message size.
+func (c *ConmonClient) ReadContainerLog(ctx context.Context, cfg *ReadLogConfig) (_ []byte, retErr error) {
+	defer c.observeRPC("ReadContainerLog", time.Now(), &retErr)
+
+	conn, err := c.newRPCConn(ctx)
+>>>>>>> acf1cda ([containers/conmon-rs#synth-773] fix: filter the container log on the server)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+
+	future, free := client.ReadLogContainer(ctx, func(p proto.Conmon_readLogContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(cfg.ID); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		req.SetTail(cfg.Tail)
+		if !cfg.Since.IsZero() {
+			req.SetSinceUnixNano(cfg.Since.UnixNano())
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", mapRPCError(err))
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	data, err := response.Data()
+	if err != nil {
+		return nil, fmt.Errorf("get data: %w", err)
+	}
+
+	if response.Truncated() {
+		c.logger.Warnf("Log of container %s got truncated to %d bytes", cfg.ID, len(data))
+	}
+
+	if cfg.Raw {
+		return data, nil
+	}
+
+	res, err := stripCRILog(data)
+	if err != nil {
+		return nil, fmt.Errorf("strip CRI log: %w", err)
+	}
+
+	return res, nil
+}
+
+// stripCRILog removes the timestamp, stream and tag prefixes from the CRI log
+// data, whereas partial records get joined with their following ones.
+func stripCRILog(data []byte) ([]byte, error) {
+	res := make([]byte, 0, len(data))
+
+	for _, line := range bytes.SplitAfter(data, []byte{criLogLineSep}) {
+		if len(line) == 0 {
+			continue
+		}
+
+		trimmedLine := bytes.TrimSuffix(line, []byte{criLogLineSep})
+		parts := bytes.SplitN(trimmedLine, []byte(criLogPartsSep), criLogPartsCount)
+		if len(parts) != criLogPartsCount {
+			return nil, fmt.Errorf("%w: %q", errInvalidCRILogLine, line)
+		}
+
+		res = append(res, parts[criLogContentIx]...)
+		if string(parts[criLogTagIx]) == criLogTagFull {
+			res = append(res, criLogLineSep)
+		}
+	}
+
+	return res, nil
+}